@@ -0,0 +1,403 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+)
+
+//
+// Record/replay ("VCR") mode of the acceptance tests.
+//
+// With IBMCLOUD_VCR_MODE=record, the HTTP interactions of the service clients
+// of each test calling TestAccPreCheck are saved, once the test passed, to a
+// sanitized cassette file named after the test in IBMCLOUD_VCR_PATH. With
+// IBMCLOUD_VCR_MODE=replay, the test is run against its cassette instead of
+// IBM Cloud, with mock credentials and no network; tests without a cassette
+// are skipped.
+//
+// The tests share TestAccProvider and the service clients it configures, so
+// their requests cannot be told apart by the transport. Tests calling
+// t.Parallel() therefore record or replay their cassette one at a time, from
+// TestAccPreCheck until the end of the test.
+//
+
+const (
+	VCRModeRecord = "record"
+	VCRModeReplay = "replay"
+
+	// vcrRedacted replaces the secrets found in the recorded interactions.
+	vcrRedacted = "REDACTED"
+)
+
+var (
+	// VCRMode is the value of IBMCLOUD_VCR_MODE, VCRModeRecord or VCRModeReplay.
+	VCRMode string
+	// VCRPath is the directory of the cassettes, relative to the package
+	// of the tests.
+	VCRPath string
+
+	// vcrCassette is the cassette of the test holding vcrTest.
+	vcrCassette *cassette
+	vcrTest     = make(chan struct{}, 1)
+	vcrMu       sync.Mutex
+	vcrRand     = map[string]*rand.Rand{}
+
+	vcrTokenRegexp  = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	vcrSecretRegexp = regexp.MustCompile(`"(apikey|api_key|access_token|refresh_token|delegated_refresh_token|password|secret|private_key)"\s*:\s*"[^"]*"`)
+
+	// vcrSecretEnvs are the environment variables whose values are scrubbed
+	// from the cassettes.
+	vcrSecretEnvs = []string{
+		"IC_API_KEY", "IBMCLOUD_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME",
+		"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN", "IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN",
+	}
+	// vcrResponseHeaders are the response headers saved in the cassettes.
+	vcrResponseHeaders = []string{"Content-Type", "Etag", "Location", "Retry-After"}
+)
+
+// cassette holds the HTTP interactions of a test.
+type cassette struct {
+	Interactions []*interaction `json:"interactions"`
+
+	name   string
+	test   *testing.T
+	served map[string]int
+}
+
+// interaction is a recorded HTTP request and its response.
+type interaction struct {
+	Method      string              `json:"method"`
+	URL         string              `json:"url"`
+	RequestBody string              `json:"request_body,omitempty"`
+	Status      int                 `json:"status"`
+	Headers     map[string][]string `json:"headers,omitempty"`
+	Body        string              `json:"body,omitempty"`
+}
+
+func init() {
+	VCRMode = os.Getenv("IBMCLOUD_VCR_MODE")
+	VCRPath = os.Getenv("IBMCLOUD_VCR_PATH")
+	if VCRPath == "" {
+		VCRPath = "./test-fixtures/cassettes"
+	}
+	if VCRMode == VCRModeRecord || VCRMode == VCRModeReplay {
+		conns.TransportWrapper = func(transport http.RoundTripper) http.RoundTripper {
+			return &vcrTransport{transport: transport}
+		}
+	}
+}
+
+// startVCR starts recording or replaying the cassette of t, in VCR mode, once
+// the cassette of the test running in VCR mode, if any, is done.
+func startVCR(t *testing.T) {
+	if VCRMode != VCRModeRecord && VCRMode != VCRModeReplay {
+		return
+	}
+	vcrMu.Lock()
+	started := vcrCassette != nil && vcrCassette.test == t
+	vcrMu.Unlock()
+	if started {
+		return
+	}
+
+	var c *cassette
+	if VCRMode == VCRModeReplay {
+		var err error
+		c, err = loadCassette(vcrCassettePath(t))
+		if os.IsNotExist(err) {
+			t.Skipf("No cassette recorded for %s", t.Name())
+		}
+		if err != nil {
+			t.Fatalf("[ERROR] Error loading cassette: %s", err)
+		}
+	} else {
+		c = &cassette{served: map[string]int{}}
+	}
+	c.name = t.Name()
+	c.test = t
+
+	vcrTest <- struct{}{}
+	vcrMu.Lock()
+	vcrCassette = c
+	vcrMu.Unlock()
+
+	// The credentials are replaced with os.Setenv, as t.Setenv is not allowed
+	// in parallel tests, and restored before the next test starts.
+	env := map[string]string{}
+	if VCRMode == VCRModeReplay {
+		for _, k := range vcrSecretEnvs {
+			env[k] = ""
+		}
+		env["IC_IAM_TOKEN"] = unittest.MockIAMToken()
+		env["IC_IAM_PROFILE_ID"] = unittest.MockTrustedProfileID
+	}
+	restore := map[string]*string{}
+	for k, v := range env {
+		if prev, ok := os.LookupEnv(k); ok {
+			restore[k] = &prev
+		} else {
+			restore[k] = nil
+		}
+		os.Setenv(k, v)
+	}
+
+	t.Cleanup(func() {
+		for k, v := range restore {
+			if v == nil {
+				os.Unsetenv(k)
+			} else {
+				os.Setenv(k, *v)
+			}
+		}
+		vcrMu.Lock()
+		vcrCassette = nil
+		vcrMu.Unlock()
+		<-vcrTest
+		if VCRMode != VCRModeRecord || t.Failed() || t.Skipped() {
+			return
+		}
+		if err := c.save(vcrCassettePath(t)); err != nil {
+			t.Errorf("[ERROR] Error saving cassette: %s", err)
+		}
+	})
+}
+
+func vcrCassettePath(t *testing.T) string {
+	name := strings.NewReplacer("/", "__", " ", "_").Replace(t.Name())
+	return filepath.Join(VCRPath, name+".json")
+}
+
+// RandIntRange returns a random integer in [minVal, maxVal), like the
+// acctest.RandIntRange of the SDK. In VCR mode the integers are the same in
+// every run of t, so that the configuration of a replayed test matches the
+// one of its cassette.
+func RandIntRange(t *testing.T, minVal, maxVal int) int {
+	if VCRMode == "" {
+		return sdkacctest.RandIntRange(minVal, maxVal)
+	}
+	vcrMu.Lock()
+	defer vcrMu.Unlock()
+	return vcrRandFor(t).Intn(maxVal-minVal) + minVal
+}
+
+// RandString returns a random string of lower case letters and digits of the
+// given length, like the acctest.RandString of the SDK, with the same values
+// in every run of t in VCR mode.
+func RandString(t *testing.T, length int) string {
+	if VCRMode == "" {
+		return sdkacctest.RandString(length)
+	}
+	vcrMu.Lock()
+	defer vcrMu.Unlock()
+	r := vcrRandFor(t)
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = sdkacctest.CharSetAlphaNum[r.Intn(len(sdkacctest.CharSetAlphaNum))]
+	}
+	return string(b)
+}
+
+// vcrRandFor returns the random source of t, seeded with its name. It must be
+// called with vcrMu held.
+func vcrRandFor(t *testing.T) *rand.Rand {
+	r, ok := vcrRand[t.Name()]
+	if !ok {
+		h := fnv.New64a()
+		h.Write([]byte(t.Name()))
+		r = rand.New(rand.NewSource(int64(h.Sum64())))
+		vcrRand[t.Name()] = r
+	}
+	return r
+}
+
+// vcrTransport records the interactions of the wrapped transport into the
+// current cassette, or replays them from it.
+type vcrTransport struct {
+	transport http.RoundTripper
+}
+
+func (v *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	vcrMu.Lock()
+	c := vcrCassette
+	vcrMu.Unlock()
+	if c == nil {
+		if VCRMode == VCRModeReplay {
+			return nil, fmt.Errorf("[ERROR] No cassette is replayed for %s %s", req.Method, req.URL)
+		}
+		return v.transport.RoundTrip(req)
+	}
+
+	var reqBody []byte
+	if req.Body != nil {
+		reqBody, _ = io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	if VCRMode == VCRModeReplay {
+		i := c.next(req.Method, vcrURL(req))
+		if i == nil {
+			return nil, fmt.Errorf("[ERROR] No interaction recorded in %s for %s %s", c.name, req.Method, vcrURL(req))
+		}
+		header := http.Header{}
+		for k, values := range i.Headers {
+			for _, value := range values {
+				header.Add(k, value)
+			}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Status, http.StatusText(i.Status)),
+			StatusCode:    i.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(i.Body)),
+			ContentLength: int64(len(i.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := v.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	if err != nil {
+		return resp, err
+	}
+	headers := map[string][]string{}
+	for _, k := range vcrResponseHeaders {
+		if values := resp.Header.Values(k); len(values) > 0 {
+			headers[k] = values
+		}
+	}
+	vcrMu.Lock()
+	c.Interactions = append(c.Interactions, &interaction{
+		Method:      req.Method,
+		URL:         vcrURL(req),
+		RequestBody: string(reqBody),
+		Status:      resp.StatusCode,
+		Headers:     headers,
+		Body:        string(respBody),
+	})
+	vcrMu.Unlock()
+	return resp, nil
+}
+
+// vcrURL is the URL interactions are matched on, with the query parameters
+// sorted by name.
+func vcrURL(req *http.Request) string {
+	url := fmt.Sprintf("%s://%s%s", req.URL.Scheme, req.URL.Host, req.URL.Path)
+	if query := req.URL.Query().Encode(); query != "" {
+		url += "?" + query
+	}
+	return url
+}
+
+// next returns the next recorded interaction for method and url. Once all of
+// them were served, the last one is served again, so that waiters polling a
+// resource more often than when the cassette was recorded still complete.
+func (c *cassette) next(method, url string) *interaction {
+	vcrMu.Lock()
+	defer vcrMu.Unlock()
+	key := method + " " + url
+	var matches []*interaction
+	for _, i := range c.Interactions {
+		if i.Method == method && i.URL == url {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+	n := c.served[key]
+	c.served[key] = n + 1
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+	return matches[n]
+}
+
+func loadCassette(path string) (*cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &cassette{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	c.served = map[string]int{}
+	return c, nil
+}
+
+// save writes the sanitized cassette to path.
+func (c *cassette) save(path string) error {
+	replacer := vcrSecretReplacer()
+	vcrMu.Lock()
+	for _, i := range c.Interactions {
+		i.URL = sanitizeVCR(replacer, i.URL)
+		i.RequestBody = sanitizeVCR(replacer, i.RequestBody)
+		i.Body = sanitizeVCR(replacer, i.Body)
+		for k, values := range i.Headers {
+			for n, value := range values {
+				i.Headers[k][n] = sanitizeVCR(replacer, value)
+			}
+		}
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	vcrMu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// vcrSecretReplacer replaces the credentials of the environment with
+// vcrRedacted and the account of the provider with the account of the mock
+// credentials used by the replays.
+func vcrSecretReplacer() *strings.Replacer {
+	var oldnew []string
+	for _, env := range vcrSecretEnvs {
+		if v := os.Getenv(env); v != "" {
+			oldnew = append(oldnew, v, vcrRedacted)
+		}
+	}
+	if meta, ok := TestAccProvider.Meta().(conns.ClientSession); ok && meta != nil {
+		if userDetails, err := meta.BluemixUserDetails(); err == nil && userDetails != nil && userDetails.UserAccount != "" {
+			oldnew = append(oldnew, userDetails.UserAccount, unittest.MockAccountID)
+		}
+	}
+	return strings.NewReplacer(oldnew...)
+}
+
+func sanitizeVCR(replacer *strings.Replacer, s string) string {
+	s = replacer.Replace(s)
+	s = vcrTokenRegexp.ReplaceAllString(s, vcrRedacted)
+	return vcrSecretRegexp.ReplaceAllString(s, fmt.Sprintf(`"$1":"%s"`, vcrRedacted))
+}
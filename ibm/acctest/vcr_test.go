@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVCRRecordReplay(t *testing.T) {
+	defer func(mode, path string) { VCRMode, VCRPath = mode, path }(VCRMode, VCRPath)
+	VCRPath = t.TempDir()
+	t.Setenv("IC_API_KEY", "test-api-key")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=test")
+		io.WriteString(w, `{"name":"test-vpc","apikey":"test-api-key","access_token":"abc"}`)
+	}))
+	client := &http.Client{Transport: &vcrTransport{transport: http.DefaultTransport}}
+	url := server.URL + "/v1/vpcs/r006-test?version=2024-01-01"
+
+	var cassettePath string
+	VCRMode = VCRModeRecord
+	t.Run("vpc", func(t *testing.T) {
+		startVCR(t)
+		cassettePath = vcrCassettePath(t)
+		resp, err := client.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	})
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("Cassette was not saved: %s", err)
+	}
+	if filepath.Base(cassettePath) != "TestVCRRecordReplay__vpc.json" {
+		t.Fatalf("Cassette saved as %s", cassettePath)
+	}
+	for _, secret := range []string{"test-api-key", "abc", "session=test"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("Cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	server.Close()
+	c, err := loadCassette(cassettePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	VCRMode = VCRModeReplay
+	vcrCassette = c
+	defer func() { vcrCassette = nil }()
+	for n := 0; n < 2; n++ {
+		resp, err := client.Get(url)
+		if err != nil {
+			t.Fatalf("Replay failed: %s", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), `"name":"test-vpc"`) || resp.Header.Get("Content-Type") != "application/json" {
+			t.Fatalf("Replay returned %s", body)
+		}
+	}
+	if _, err := client.Get(server.URL + "/v1/subnets"); err == nil {
+		t.Fatal("Replay served a request which was not recorded")
+	}
+	if _, err := client.Get(server.URL + "/v1/vpcs/r006-test?version=2025-01-01"); err == nil {
+		t.Fatal("Replay served a request with another query")
+	}
+	if _, err := client.Get(server.URL + "/v1/vpcs/r006-test?version=2024-01-01&"); err != nil {
+		t.Fatalf("Replay did not serve the same query: %s", err)
+	}
+}
+
+func TestVCRParallel(t *testing.T) {
+	defer func(mode, path string) { VCRMode, VCRPath = mode, path }(VCRMode, VCRPath)
+	VCRMode = VCRModeRecord
+	VCRPath = t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.URL.Path)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: &vcrTransport{transport: http.DefaultTransport}}
+
+	names := []string{"a", "b", "c"}
+	t.Run("group", func(t *testing.T) {
+		for _, name := range names {
+			name := name
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				startVCR(t)
+				for n := 0; n < 3; n++ {
+					resp, err := client.Get(server.URL + "/" + name)
+					if err != nil {
+						t.Fatal(err)
+					}
+					resp.Body.Close()
+				}
+			})
+		}
+	})
+	for _, name := range names {
+		c, err := loadCassette(filepath.Join(VCRPath, "TestVCRParallel__group__"+name+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, i := range c.Interactions {
+			if !strings.HasSuffix(i.URL, "/"+name) {
+				t.Errorf("Cassette of %s recorded %s", name, i.URL)
+			}
+		}
+		if len(c.Interactions) != 3 {
+			t.Errorf("Cassette of %s recorded %d interactions, expected 3", name, len(c.Interactions))
+		}
+	}
+}
+
+func TestVCRRandString(t *testing.T) {
+	defer func(mode string) { VCRMode = mode }(VCRMode)
+	VCRMode = VCRModeReplay
+
+	first := RandString(t, 8)
+	delete(vcrRand, t.Name())
+	if second := RandString(t, 8); first != second || len(first) != 8 {
+		t.Fatalf("RandString returned %q and then %q", first, second)
+	}
+}
+
+func TestVCRRandIntRange(t *testing.T) {
+	defer func(mode string) { VCRMode = mode }(VCRMode)
+	VCRMode = VCRModeReplay
+
+	first := []int{RandIntRange(t, 10, 100), RandIntRange(t, 10, 100)}
+	delete(vcrRand, t.Name())
+	second := []int{RandIntRange(t, 10, 100), RandIntRange(t, 10, 100)}
+	if first[0] != second[0] || first[1] != second[1] {
+		t.Fatalf("RandIntRange returned %v and then %v", first, second)
+	}
+}
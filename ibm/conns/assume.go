@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AssumeTrustedProfile is the trusted profile, possibly in another account,
+// whose identity the provider assumes with its credentials.
+type AssumeTrustedProfile struct {
+	ProfileID   string
+	ProfileCRN  string
+	ProfileName string
+	// AccountID is the account of the trusted profile given by ProfileName.
+	AccountID string
+}
+
+// configureAssumeTrustedProfile exchanges the credentials of c for the token
+// of c.AssumeTrustedProfile, which is used by all the service clients.
+func (c *Config) configureAssumeTrustedProfile() error {
+	profile := c.AssumeTrustedProfile
+	if profile == nil {
+		return nil
+	}
+	ids := 0
+	for _, id := range []string{profile.ProfileID, profile.ProfileCRN, profile.ProfileName} {
+		if id != "" {
+			ids++
+		}
+	}
+	if ids != 1 {
+		return fmt.Errorf("[ERROR] Exactly one of profile_id, profile_crn and profile_name must be provided in assume_trusted_profile")
+	}
+	if (profile.ProfileName != "") != (profile.AccountID != "") {
+		return fmt.Errorf("[ERROR] account_id must be provided in assume_trusted_profile with profile_name, and only with it")
+	}
+
+	iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, c.iamEndpoint())
+	var base tokenAuthenticator
+	switch {
+	case c.authenticator != nil:
+		base = c.authenticator
+	case c.BluemixAPIKey != "":
+		base = &core.IamAuthenticator{
+			ApiKey: c.BluemixAPIKey,
+			URL:    iamURL,
+			Client: c.wrapHTTPClient(core.DefaultHTTPClient()),
+		}
+	case c.IAMRefreshToken != "":
+		base = &core.IamAuthenticator{
+			RefreshToken: c.IAMRefreshToken,
+			ClientId:     "bx",
+			ClientSecret: "bx",
+			URL:          iamURL,
+			Client:       c.wrapHTTPClient(core.DefaultHTTPClient()),
+		}
+	case c.IAMToken != "":
+		base = &bearerTokenAuthenticator{core.BearerTokenAuthenticator{
+			BearerToken: strings.TrimPrefix(c.IAMToken, "Bearer "),
+		}}
+	default:
+		return fmt.Errorf("[ERROR] assume_trusted_profile requires an API key, an IAM token or an auth_type")
+	}
+
+	authenticator := &assumeAuthenticator{
+		base:    base,
+		profile: *profile,
+		url:     iamURL,
+		client:  c.wrapHTTPClient(core.DefaultHTTPClient()),
+	}
+	token, err := authenticator.GetToken()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error assuming the trusted profile: %s", err)
+	}
+	log.Printf("[INFO] Assumed the trusted profile %s%s%s", profile.ProfileID, profile.ProfileCRN, profile.ProfileName)
+
+	// The sessions only know about the token of the trusted profile, which
+	// is refreshed by the authenticator.
+	c.authenticator = authenticator
+	c.IAMToken = "Bearer " + token
+	c.IAMRefreshToken = ""
+	c.BluemixAPIKey = ""
+	return nil
+}
+
+// bearerTokenAuthenticator is the static IAM token of the provider, which
+// cannot be refreshed.
+type bearerTokenAuthenticator struct {
+	core.BearerTokenAuthenticator
+}
+
+func (a *bearerTokenAuthenticator) GetToken() (string, error) {
+	return a.BearerToken, nil
+}
+
+// assumeAuthenticator authenticates with the token of a trusted profile,
+// obtained with the assume grant of IAM from the token of a base
+// authenticator. Unlike core.IamAssumeAuthenticator, the base credential is
+// not limited to an API key.
+type assumeAuthenticator struct {
+	base    tokenAuthenticator
+	profile AssumeTrustedProfile
+	url     string
+	client  *http.Client
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+// GetToken returns the token of the trusted profile, which is requested again
+// once 80% of its lifetime has elapsed.
+func (a *assumeAuthenticator) GetToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token != "" && time.Now().Before(a.refreshAt) {
+		return a.token, nil
+	}
+
+	baseToken, err := a.base.GetToken()
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type":   {"urn:ibm:params:oauth:grant-type:assume"},
+		"access_token": {baseToken},
+	}
+	switch {
+	case a.profile.ProfileCRN != "":
+		form.Set("profile_crn", a.profile.ProfileCRN)
+	case a.profile.ProfileID != "":
+		form.Set("profile_id", a.profile.ProfileID)
+	default:
+		form.Set("profile_name", a.profile.ProfileName)
+		form.Set("account", a.profile.AccountID)
+	}
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(a.url, "/")+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("IAM returned %d: %s", resp.StatusCode, body)
+	}
+	tokenResponse := &core.IamTokenServerResponse{}
+	if err := json.Unmarshal(body, tokenResponse); err != nil {
+		return "", err
+	}
+	if tokenResponse.AccessToken == "" {
+		return "", fmt.Errorf("IAM returned no access token")
+	}
+	a.token = tokenResponse.AccessToken
+	a.refreshAt = time.Now().Add(time.Duration(tokenResponse.ExpiresIn) * time.Second * 8 / 10)
+	return a.token, nil
+}
+
+func (a *assumeAuthenticator) Authenticate(req *http.Request) error {
+	token, err := a.GetToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (a *assumeAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_IAM_ASSUME
+}
+
+func (a *assumeAuthenticator) Validate() error {
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// AuthTypeContainer authenticates with the compute resource token of a
+	// Kubernetes service account, like the ones of IKS and ROKS pods, and a
+	// trusted profile.
+	AuthTypeContainer = "container"
+	// AuthTypeVPC authenticates with the identity of the VPC virtual server
+	// instance, obtained from its metadata service.
+	AuthTypeVPC = "vpc"
+	// AuthTypeCLI authenticates with the session of the ibmcloud CLI.
+	AuthTypeCLI = "cli"
+)
+
+// AuthTypes are the supported values of Config.AuthType.
+var AuthTypes = []string{AuthTypeContainer, AuthTypeVPC, AuthTypeCLI}
+
+// cliConfig is the part of the config.json file of the ibmcloud CLI holding
+// its session.
+type cliConfig struct {
+	IAMToken        string `json:"IAMToken"`
+	IAMRefreshToken string `json:"IAMRefreshToken"`
+}
+
+// CLIConfigFile returns the default location of the config.json file of the
+// ibmcloud CLI, in IBMCLOUD_HOME or in the home directory of the user.
+func CLIConfigFile() string {
+	home := os.Getenv("IBMCLOUD_HOME")
+	if home == "" {
+		home, _ = os.UserHomeDir()
+	}
+	return filepath.Join(home, ".bluemix", "config.json")
+}
+
+// configureAuthType sets up the credentials of c for its AuthType. With the
+// compute resource authenticators, the IAM token of the sessions is the token
+// of the trusted profile, which the authenticator keeps refreshing.
+func (c *Config) configureAuthType() error {
+	if c.AuthType == "" {
+		return nil
+	}
+	if c.BluemixAPIKey != "" || c.IAMToken != "" {
+		log.Printf("[WARN] Authenticating with auth_type %s, ignoring the API key and IAM token", c.AuthType)
+		c.BluemixAPIKey = ""
+		c.IAMToken = ""
+		c.IAMRefreshToken = ""
+	}
+
+	var authenticator tokenAuthenticator
+	switch c.AuthType {
+	case AuthTypeCLI:
+		return c.loadCLIConfig()
+	case AuthTypeContainer:
+		if c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "" {
+			return fmt.Errorf("[ERROR] iam_profile_id or iam_profile_name must be provided with auth_type %s", AuthTypeContainer)
+		}
+		authenticator = &core.ContainerAuthenticator{
+			CRTokenFilename: c.CRTokenFile,
+			IAMProfileID:    c.IAMTrustedProfileID,
+			IAMProfileName:  c.IAMTrustedProfileName,
+			URL:             EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, c.iamEndpoint()),
+			Client:          c.wrapHTTPClient(core.DefaultHTTPClient()),
+		}
+	case AuthTypeVPC:
+		if c.IAMTrustedProfileName != "" {
+			return fmt.Errorf("[ERROR] iam_profile_name is not supported with auth_type %s, use iam_profile_id", AuthTypeVPC)
+		}
+		vpcAuthenticator := &core.VpcInstanceAuthenticator{
+			URL:    os.Getenv("IBMCLOUD_VPC_METADATA_ENDPOINT"),
+			Client: c.wrapHTTPClient(core.DefaultHTTPClient()),
+		}
+		if strings.HasPrefix(c.IAMTrustedProfileID, "crn:") {
+			vpcAuthenticator.IAMProfileCRN = c.IAMTrustedProfileID
+		} else {
+			vpcAuthenticator.IAMProfileID = c.IAMTrustedProfileID
+		}
+		authenticator = vpcAuthenticator
+	default:
+		return fmt.Errorf("[ERROR] Unsupported auth_type %s", c.AuthType)
+	}
+
+	if err := authenticator.Validate(); err != nil {
+		return fmt.Errorf("[ERROR] Error configuring auth_type %s: %s", c.AuthType, err)
+	}
+	token, err := authenticator.GetToken()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error authenticating with auth_type %s: %s", c.AuthType, err)
+	}
+	c.authenticator = authenticator
+	c.IAMToken = "Bearer " + token
+	return nil
+}
+
+// loadCLIConfig sets the IAM tokens of c to the ones of the ibmcloud CLI
+// session, which must have been started with ibmcloud login.
+func (c *Config) loadCLIConfig() error {
+	path := c.CLIConfigFile
+	if path == "" {
+		path = CLIConfigFile()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading the ibmcloud CLI configuration: %s", err)
+	}
+	var config cliConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the ibmcloud CLI configuration %s: %s", path, err)
+	}
+	if config.IAMToken == "" || config.IAMRefreshToken == "" {
+		return fmt.Errorf("[ERROR] The ibmcloud CLI configuration %s has no session, run ibmcloud login", path)
+	}
+	c.IAMToken = config.IAMToken
+	c.IAMRefreshToken = config.IAMRefreshToken
+	return nil
+}
+
+// iamEndpoint returns the endpoint of IAM for the visibility of c.
+func (c *Config) iamEndpoint() string {
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			return ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		}
+		return ContructEndpoint("private.iam", cloudEndpoint)
+	}
+	return ContructEndpoint("iam", cloudEndpoint)
+}
+
+// tokenAuthenticator is an authenticator of the compute resource identity.
+type tokenAuthenticator interface {
+	core.Authenticator
+	GetToken() (string, error)
+}
+
+// tokenTransport replaces the IAM token the bluemix-go and SoftLayer sessions
+// were configured with by the current token of the authenticator, as these
+// sessions are unable to refresh the token of a trusted profile themselves.
+type tokenTransport struct {
+	transport     http.RoundTripper
+	authenticator tokenAuthenticator
+	token         string
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ") == t.token {
+		token, err := t.authenticator.GetToken()
+		if err != nil {
+			return nil, err
+		}
+		if token != t.token {
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	return t.transport.RoundTrip(req)
+}
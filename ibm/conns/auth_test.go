@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func testIAMToken(id string) string {
+	claims, _ := json.Marshal(map[string]interface{}{
+		"id":      id,
+		"iam_id":  id,
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"exp":     4102444800,
+		"account": map[string]interface{}{"bss": "testaccount"},
+	})
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + enc.EncodeToString(claims) + "." + enc.EncodeToString([]byte("test"))
+}
+
+func TestAuthTypeContainer(t *testing.T) {
+	token := testIAMToken("iam-Profile-test")
+	var grants []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/identity/token":
+			r.ParseForm()
+			grants = append(grants, fmt.Sprintf("%s %s %s", r.Form.Get("grant_type"), r.Form.Get("cr_token"), r.Form.Get("profile_id")))
+			fmt.Fprintf(w, `{"access_token":%q,"token_type":"Bearer","expires_in":3600,"expiration":4102444800}`, token)
+		case "/v1/vpcs/r006-test":
+			if r.Header.Get("Authorization") != "Bearer "+token {
+				w.WriteHeader(401)
+				return
+			}
+			io.WriteString(w, `{"id":"r006-test","name":"test-vpc"}`)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+	crTokenFile := filepath.Join(t.TempDir(), "token")
+	os.WriteFile(crTokenFile, []byte("test-cr-token"), 0600)
+	t.Setenv("IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+	t.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", server.URL+"/v1")
+
+	c := &Config{
+		Region:              "us-south",
+		Visibility:          "public",
+		AuthType:            AuthTypeContainer,
+		CRTokenFile:         crTokenFile,
+		IAMTrustedProfileID: "Profile-test",
+	}
+	session, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("ClientSession returned an error: %s", err)
+	}
+	if len(grants) != 1 || grants[0] != "urn:ibm:params:oauth:grant-type:cr-token test-cr-token Profile-test" {
+		t.Fatalf("IAM received %v", grants)
+	}
+	userDetails, err := session.(ClientSession).BluemixUserDetails()
+	if err != nil || userDetails.UserID != "iam-Profile-test" || userDetails.UserAccount != "testaccount" {
+		t.Fatalf("BluemixUserDetails returned %+v, %v", userDetails, err)
+	}
+	vpcClient, err := session.(ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatalf("VpcV1API returned an error: %s", err)
+	}
+	if _, _, err := vpcClient.GetVPC(&vpcv1.GetVPCOptions{ID: core.StringPtr("r006-test")}); err != nil {
+		t.Fatalf("GetVPC returned an error: %s", err)
+	}
+}
+
+func TestAuthTypeContainerProfile(t *testing.T) {
+	c := &Config{AuthType: AuthTypeContainer}
+	if err := c.configureAuthType(); err == nil || !strings.Contains(err.Error(), "iam_profile_id") {
+		t.Fatalf("configureAuthType returned %v without a trusted profile", err)
+	}
+}
+
+func TestAuthTypeCLI(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("IBMCLOUD_HOME", home)
+	c := &Config{AuthType: AuthTypeCLI, BluemixAPIKey: "test-api-key"}
+	if err := c.configureAuthType(); err == nil {
+		t.Fatal("configureAuthType succeeded without a CLI configuration")
+	}
+
+	os.MkdirAll(filepath.Join(home, ".bluemix"), 0700)
+	os.WriteFile(filepath.Join(home, ".bluemix", "config.json"), []byte(`{"IAMToken":"Bearer test-token","IAMRefreshToken":"test-refresh-token","Region":"eu-de"}`), 0600)
+	if err := c.configureAuthType(); err != nil {
+		t.Fatalf("configureAuthType returned an error: %s", err)
+	}
+	if c.IAMToken != "Bearer test-token" || c.IAMRefreshToken != "test-refresh-token" || c.BluemixAPIKey != "" {
+		t.Fatalf("configureAuthType configured %+v", c)
+	}
+}
+
+type testTokenAuthenticator struct {
+	core.NoAuthAuthenticator
+	token string
+}
+
+func (a *testTokenAuthenticator) GetToken() (string, error) {
+	return a.token, nil
+}
+
+func TestTokenTransport(t *testing.T) {
+	var authorizations []string
+	authenticator := &testTokenAuthenticator{token: "token-1"}
+	transport := &tokenTransport{
+		authenticator: authenticator,
+		token:         "token-1",
+		transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			authorizations = append(authorizations, req.Header.Get("Authorization"))
+			return &http.Response{StatusCode: 200, Body: http.NoBody, Request: req}, nil
+		}),
+	}
+	for _, authorization := range []string{"Bearer token-1", "token-1", "Bearer other-token"} {
+		authenticator.token = "token-2"
+		req, _ := http.NewRequest("GET", "https://us-south.containers.cloud.ibm.com/v1/clusters", nil)
+		req.Header.Set("Authorization", authorization)
+		transport.RoundTrip(req)
+	}
+	expected := []string{"Bearer token-2", "Bearer token-2", "Bearer other-token"}
+	for n := range expected {
+		if authorizations[n] != expected[n] {
+			t.Fatalf("Requests were sent with %v, expected %v", authorizations, expected)
+		}
+	}
+}
+
+func TestAssumeTrustedProfile(t *testing.T) {
+	token := testIAMToken("iam-Profile-child")
+	var grants []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		r.ParseForm()
+		switch r.Form.Get("grant_type") {
+		case "urn:ibm:params:oauth:grant-type:apikey":
+			grants = append(grants, "apikey "+r.Form.Get("apikey"))
+			fmt.Fprintf(w, `{"access_token":%q,"refresh_token":"test-refresh-token","token_type":"Bearer","expires_in":3600,"expiration":4102444800}`, testIAMToken("IBMid-test"))
+		case "urn:ibm:params:oauth:grant-type:assume":
+			grants = append(grants, fmt.Sprintf("assume %s %s", r.Form.Get("profile_name"), r.Form.Get("account")))
+			fmt.Fprintf(w, `{"access_token":%q,"token_type":"Bearer","expires_in":3600,"expiration":4102444800}`, token)
+		default:
+			w.WriteHeader(400)
+		}
+	}))
+	defer server.Close()
+	t.Setenv("IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+
+	c := &Config{
+		Region:        "us-south",
+		Visibility:    "public",
+		BluemixAPIKey: "test-api-key",
+		AssumeTrustedProfile: &AssumeTrustedProfile{
+			ProfileName: "terraform",
+			AccountID:   "childaccount",
+		},
+	}
+	session, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("ClientSession returned an error: %s", err)
+	}
+	if len(grants) != 2 || grants[0] != "apikey test-api-key" || grants[1] != "assume terraform childaccount" {
+		t.Fatalf("IAM received %v", grants)
+	}
+	userDetails, err := session.(ClientSession).BluemixUserDetails()
+	if err != nil || userDetails.UserID != "iam-Profile-child" {
+		t.Fatalf("BluemixUserDetails returned %+v, %v", userDetails, err)
+	}
+	if c.IAMToken != "Bearer "+token || c.BluemixAPIKey != "" {
+		t.Fatalf("The sessions were configured with %q and API key %q", c.IAMToken, c.BluemixAPIKey)
+	}
+}
+
+func TestAssumeTrustedProfileInvalid(t *testing.T) {
+	for _, profile := range []AssumeTrustedProfile{
+		{},
+		{ProfileID: "Profile-test", ProfileName: "terraform", AccountID: "childaccount"},
+		{ProfileName: "terraform"},
+		{ProfileID: "Profile-test", AccountID: "childaccount"},
+	} {
+		c := &Config{IAMToken: "test-token", AssumeTrustedProfile: &profile}
+		if err := c.configureAssumeTrustedProfile(); err == nil {
+			t.Errorf("configureAssumeTrustedProfile accepted %+v", profile)
+		}
+	}
+}
@@ -5,16 +5,14 @@ package conns
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"log"
 	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
@@ -23,6 +21,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	apigateway "github.com/IBM/apigateway-go-sdk/apigatewaycontrollerapiv1"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
@@ -200,6 +199,25 @@ type Config struct {
 	// TrustedProfileToken Token
 	IAMTrustedProfileID string
 
+	// IAMTrustedProfileName is the name of the trusted profile of the
+	// compute resource authenticators, as an alternative to its ID.
+	IAMTrustedProfileName string
+
+	// AuthType selects an authentication other than the API key and the IAM
+	// tokens; see AuthTypes.
+	AuthType string
+
+	// CRTokenFile is the compute resource token file of AuthTypeContainer.
+	CRTokenFile string
+
+	// CLIConfigFile is the config.json file of the ibmcloud CLI used by
+	// AuthTypeCLI, it defaults to CLIConfigFile().
+	CLIConfigFile string
+
+	// AssumeTrustedProfile is the trusted profile whose identity is assumed
+	// with the credentials above, if any.
+	AssumeTrustedProfile *AssumeTrustedProfile
+
 	// IAM Refresh Token
 	IAMRefreshToken string
 
@@ -207,6 +225,31 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// RateLimits are the client-side rate limits of the requests, by service
+	// family; see RateLimitServiceFamilies.
+	RateLimits map[string]RateLimit
+
+	// TraceFile is the file the HTTP requests are traced to, if any.
+	TraceFile string
+
+	// DriftReportFile is the file the drift of the refreshed resources is
+	// reported to, if any.
+	DriftReportFile string
+
+	// DeletionProtection are the rules preventing the deletion of resources.
+	DeletionProtection []DeletionProtectionRule
+
+	// DefaultTags and DefaultAccessTags are attached to every resource with
+	// tags, respectively access_tags.
+	DefaultTags       []string
+	DefaultAccessTags []string
+
+	// PollIntervals are the intervals of the waiters, by resource type.
+	PollIntervals []PollIntervalRule
+
+	rateLimiter   *rateLimiter
+	authenticator tokenAuthenticator
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -225,6 +268,10 @@ type ClientSession interface {
 	BluemixAcccountAPI() (accountv2.AccountServiceAPI, error)
 	BluemixAcccountv1API() (accountv1.AccountServiceAPI, error)
 	BluemixUserDetails() (*UserConfig, error)
+	DeletionProtection() []DeletionProtectionRule
+	DefaultTags(tagType string) []string
+	PollInterval(resourceType string) waiter.Interval
+	ServiceEndpoints(keys []string) ([]ServiceEndpoint, error)
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
@@ -329,432 +376,553 @@ type ClientSession interface {
 type clientSession struct {
 	session *Session
 
-	appidErr error
-	appidAPI *appid.AppIDManagementV4
+	// Shared inputs for the service clients, which are only constructed the
+	// first time their accessor is called.
+	config        *Config
+	endpointsFile *EndpointsFile
+	endpointsMu   sync.Mutex
+	endpoints     map[string]string
+	iamURL        string
+	authenticator core.Authenticator
+
+	appIDOnce sync.Once
+	appidErr  error
+	appidAPI  *appid.AppIDManagementV4
 
-	apigatewayErr error
-	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
+	apiGatewayOnce sync.Once
+	apigatewayErr  error
+	apigatewayAPI  *apigateway.ApiGatewayControllerApiV1
 
+	accountV2Once        sync.Once
 	accountConfigErr     error
 	bmxAccountServiceAPI accountv2.AccountServiceAPI
 
+	accountV1Once          sync.Once
 	accountV1ConfigErr     error
 	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
 
+	configurationAggregatorOnce      sync.Once
 	configurationAggregatorClient    *configurationaggregatorv1.ConfigurationAggregatorV1
 	configurationAggregatorClientErr error
 
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
-	csConfigErr  error
-	csServiceAPI containerv1.ContainerServiceAPI
+	containerOnce sync.Once
+	csConfigErr   error
+	csServiceAPI  containerv1.ContainerServiceAPI
 
-	csv2ConfigErr  error
-	csv2ServiceAPI containerv2.ContainerServiceAPI
+	vpcContainerOnce sync.Once
+	csv2ConfigErr    error
+	csv2ServiceAPI   containerv2.ContainerServiceAPI
 
+	containerRegistryOnce      sync.Once
 	containerRegistryClientErr error
 	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
 
+	mccpOnce     sync.Once
 	cfConfigErr  error
 	cfServiceAPI mccpv2.MccpServiceAPI
 
 	cisConfigErr  error
 	cisServiceAPI cisv1.CisServiceAPI
 
+	functionOnce      sync.Once
 	functionConfigErr error
 	functionClient    *whisk.Client
 
+	globalSearchOnce       sync.Once
 	globalSearchConfigErr  error
 	globalSearchServiceAPI globalsearchv2.GlobalSearchServiceAPI
 
+	globalTaggingOnce       sync.Once
 	globalTaggingConfigErr  error
 	globalTaggingServiceAPI globaltaggingv3.GlobalTaggingServiceAPI
 
+	globalTaggingV1Once       sync.Once
 	globalTaggingConfigErrV1  error
 	globalTaggingServiceAPIV1 globaltaggingv1.GlobalTaggingV1
 
+	globalSearchV2Once       sync.Once
 	globalSearchConfigErrV2  error
 	globalSearchServiceAPIV2 searchv2.GlobalSearchV2
 
+	cloudShellOnce         sync.Once
 	ibmCloudShellClient    *ibmcloudshellv1.IBMCloudShellV1
 	ibmCloudShellClientErr error
 
-	userManagementErr error
-	userManagementAPI usermanagementv2.UserManagementAPI
+	userManagementOnce sync.Once
+	userManagementErr  error
+	userManagementAPI  usermanagementv2.UserManagementAPI
 
+	icdOnce       sync.Once
 	icdConfigErr  error
 	icdServiceAPI icdv4.ICDServiceAPI
 
+	cloudDatabasesOnce      sync.Once
 	cloudDatabasesClientErr error
 	cloudDatabasesClient    *clouddatabasesv5.CloudDatabasesV5
 
+	bmxResourceControllerOnce    sync.Once
 	resourceControllerConfigErr  error
 	resourceControllerServiceAPI controller.ResourceControllerAPI
 
+	bmxResourceControllerV2Once    sync.Once
 	resourceControllerConfigErrv2  error
 	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
 
+	resourceManagementV2Once       sync.Once
 	resourceManagementConfigErrv2  error
 	resourceManagementServiceAPIv2 managementv2.ResourceManagementAPIv2
 
+	resourceCatalogOnce       sync.Once
 	resourceCatalogConfigErr  error
 	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
 
+	ibmPIOnce      sync.Once
 	ibmpiConfigErr error
 	ibmpiSession   *ibmpisession.IBMPISession
 
-	kpErr error
-	kpAPI *kp.API
+	keyProtectOnce sync.Once
+	kpErr          error
+	kpAPI          *kp.API
 
-	kmsErr error
-	kmsAPI *kp.API
+	keyManagementOnce sync.Once
+	kmsErr            error
+	kmsAPI            *kp.API
 
+	hpcsOnce        sync.Once
 	hpcsEndpointErr error
 	hpcsEndpointAPI hpcs.HPCSV2
 
+	ukoOnce      sync.Once
 	ukoClient    *ukov4.UkoV4
 	ukoClientErr error
 
-	pDNSClient *dns.DnsSvcsV1
-	pDNSErr    error
+	privateDNSOnce sync.Once
+	pDNSClient     *dns.DnsSvcsV1
+	pDNSErr        error
 
 	bluemixSessionErr error
 
+	pushServiceOnce      sync.Once
 	pushServiceClient    *pushservicev1.PushServiceV1
 	pushServiceClientErr error
 
+	eventNotificationsOnce         sync.Once
 	eventNotificationsApiClient    *eventnotificationsv1.EventNotificationsV1
 	eventNotificationsApiClientErr error
 
+	appConfigurationOnce      sync.Once
 	appConfigurationClient    *appconfigurationv1.AppConfigurationV1
 	appConfigurationClientErr error
 
-	vpcErr     error
-	vpcAPI     *vpc.VpcV1
-	vpcbetaErr error
-	vpcBetaAPI *vpcbeta.VpcbetaV1
+	vpcOnce     sync.Once
+	vpcErr      error
+	vpcAPI      *vpc.VpcV1
+	vpcBetaOnce sync.Once
+	vpcbetaErr  error
+	vpcBetaAPI  *vpcbeta.VpcbetaV1
 
-	directlinkAPI *dl.DirectLinkV1
-	directlinkErr error
-	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
-	dlProviderErr error
+	directLinkOnce         sync.Once
+	directlinkAPI          *dl.DirectLinkV1
+	directlinkErr          error
+	directLinkProviderOnce sync.Once
+	dlProviderAPI          *dlProviderV2.DirectLinkProviderV2
+	dlProviderErr          error
 
-	cosConfigErr error
-	cosConfigAPI *cosconfig.ResourceConfigurationV1
+	cosConfigOnce sync.Once
+	cosConfigErr  error
+	cosConfigAPI  *cosconfig.ResourceConfigurationV1
 
-	transitgatewayAPI *tg.TransitGatewayApisV1
-	transitgatewayErr error
+	transitGatewayOnce sync.Once
+	transitgatewayAPI  *tg.TransitGatewayApisV1
+	transitgatewayErr  error
 
-	functionIAMNamespaceAPI functions.FunctionServiceAPI
-	functionIAMNamespaceErr error
+	functionIAMNamespaceOnce sync.Once
+	functionIAMNamespaceAPI  functions.FunctionServiceAPI
+	functionIAMNamespaceErr  error
 
 	// CIS Zones
+	cisZonesOnce     sync.Once
 	cisZonesErr      error
 	cisZonesV1Client *ciszonesv1.ZonesV1
 
 	// CIS Alerts
+	cisAlertsOnce   sync.Once
 	cisAlertsClient *cisalertsv1.AlertsV1
 	cisAlertsErr    error
 
 	// CIS Rulesets
+	cisRulesetsOnce   sync.Once
 	cisRulesetsClient *cisrulesetsv1.RulesetsV1
 	cisRulesetsErr    error
 
 	// CIS Authenticated Origin Pull
+	cisOriginAuthOnce    sync.Once
 	cisOriginAuthClient  *cisoriginpull.AuthenticatedOriginPullApiV1
 	cisOriginAuthPullErr error
 
 	// CIS dns service options
+	cisDNSRecordsOnce   sync.Once
 	cisDNSErr           error
 	cisDNSRecordsClient *cisdnsrecordsv1.DnsRecordsV1
 
 	// CIS dns bulk service options
+	cisDNSRecordBulkOnce   sync.Once
 	cisDNSBulkErr          error
 	cisDNSRecordBulkClient *cisdnsbulkv1.DnsRecordBulkV1
 
 	// CIS Global Load Balancer Pool service options
+	cisGLBPoolOnce   sync.Once
 	cisGLBPoolErr    error
 	cisGLBPoolClient *cisglbpoolv0.GlobalLoadBalancerPoolsV0
 
 	// CIS GLB service options
+	cisGLBOnce   sync.Once
 	cisGLBErr    error
 	cisGLBClient *cisglbv1.GlobalLoadBalancerV1
 
 	// CIS GLB health check service options
+	cisGLBHealthCheckOnce   sync.Once
 	cisGLBHealthCheckErr    error
 	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
 
 	// CIS IP service options
+	cisIPOnce   sync.Once
 	cisIPErr    error
 	cisIPClient *cisipv1.CisIpApiV1
 
 	// CIS Zone Rate Limits service options
+	cisRLOnce   sync.Once
 	cisRLErr    error
 	cisRLClient *cisratelimitv1.ZoneRateLimitsV1
 
 	// CIS Page Rules service options
+	cisPageRuleOnce   sync.Once
 	cisPageRuleErr    error
 	cisPageRuleClient *cispagerulev1.PageRuleApiV1
 
 	// CIS Edge Functions service options
+	cisEdgeFunctionOnce   sync.Once
 	cisEdgeFunctionErr    error
 	cisEdgeFunctionClient *cisedgefunctionv1.EdgeFunctionsApiV1
 
 	// CIS SSL certificate service options
+	cisSSLOnce   sync.Once
 	cisSSLErr    error
 	cisSSLClient *cissslv1.SslCertificateApiV1
 
 	// CIS WAF Package service options
+	cisWAFPackageOnce   sync.Once
 	cisWAFPackageErr    error
 	cisWAFPackageClient *ciswafpackagev1.WafRulePackagesApiV1
 
 	// CIS Zone Setting service options
+	cisDomainSettingsOnce   sync.Once
 	cisDomainSettingsErr    error
 	cisDomainSettingsClient *cisdomainsettingsv1.ZonesSettingsV1
 
 	// CIS Routing service options
+	cisRoutingOnce   sync.Once
 	cisRoutingErr    error
 	cisRoutingClient *cisroutingv1.RoutingV1
 
 	// CIS WAF Group service options
+	cisWAFGroupOnce   sync.Once
 	cisWAFGroupErr    error
 	cisWAFGroupClient *ciswafgroupv1.WafRuleGroupsApiV1
 
 	// CIS Caching service options
+	cisCacheOnce   sync.Once
 	cisCacheErr    error
 	cisCacheClient *ciscachev1.CachingApiV1
 
 	// CIS Custom Pages service options
+	cisCustomPageOnce   sync.Once
 	cisCustomPageErr    error
 	cisCustomPageClient *ciscustompagev1.CustomPagesV1
 
 	// CIS Firewall Access rule service option
+	cisAccessRuleOnce   sync.Once
 	cisAccessRuleErr    error
 	cisAccessRuleClient *cisaccessrulev1.ZoneFirewallAccessRulesV1
 
 	// CIS User Agent Blocking Rule service option
+	cisUARuleOnce   sync.Once
 	cisUARuleErr    error
 	cisUARuleClient *cisuarulev1.UserAgentBlockingRulesV1
 
 	// CIS Firewall Lockdwon Rule service option
+	cisLockdownOnce   sync.Once
 	cisLockdownErr    error
 	cisLockdownClient *cislockdownv1.ZoneLockdownV1
 
 	// CIS LogpushJobs service option
+	cisLogpushJobsOnce   sync.Once
 	cisLogpushJobsClient *cislogpushjobsapiv1.LogpushJobsApiV1
 	cisLogpushJobsErr    error
 
 	// CIS Range app service option
+	cisRangeAppOnce   sync.Once
 	cisRangeAppErr    error
 	cisRangeAppClient *cisrangeappv1.RangeApplicationsV1
 
 	// CIS WAF rule service options
+	cisWAFRuleOnce   sync.Once
 	cisWAFRuleErr    error
 	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
 	// IAM Identity Option
-	iamIdentityErr error
-	iamIdentityAPI *iamidentity.IamIdentityV1
+	iamIdentityOnce sync.Once
+	iamIdentityErr  error
+	iamIdentityAPI  *iamidentity.IamIdentityV1
 
 	// Resource Manager Option
-	resourceManagerErr error
-	resourceManagerAPI *resourcemanager.ResourceManagerV2
+	resourceManagerOnce sync.Once
+	resourceManagerErr  error
+	resourceManagerAPI  *resourcemanager.ResourceManagerV2
 
 	// Catalog Management Option
+	catalogManagementOnce      sync.Once
 	catalogManagementClient    *catalogmanagementv1.CatalogManagementV1
 	catalogManagementClientErr error
 
+	partnerCenterSellOnce      sync.Once
 	partnerCenterSellClient    *partnercentersellv1.PartnerCenterSellV1
 	partnerCenterSellClientErr error
 
+	enterpriseManagementOnce      sync.Once
 	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
 	enterpriseManagementClientErr error
 
 	// Resource Controller Option
-	resourceControllerErr error
-	resourceControllerAPI *resourcecontroller.ResourceControllerV2
+	resourceControllerOnce sync.Once
+	resourceControllerErr  error
+	resourceControllerAPI  *resourcecontroller.ResourceControllerV2
 
 	// BAAS service
+	backupRecoveryOnce      sync.Once
 	backupRecoveryClient    *backuprecoveryv1.BackupRecoveryV1
 	backupRecoveryClientErr error
 
+	secretsManagerOnce      sync.Once
 	secretsManagerClient    *secretsmanagerv2.SecretsManagerV2
 	secretsManagerClientErr error
 
 	// Schematics service options
+	schematicsOnce      sync.Once
 	schematicsClient    *schematicsv1.SchematicsV1
 	schematicsClientErr error
 
 	// Satellite service
+	satelliteOnce      sync.Once
 	satelliteClient    *kubernetesserviceapiv1.KubernetesServiceApiV1
 	satelliteClientErr error
 
 	// IAM Policy Management
-	iamPolicyManagementErr error
-	iamPolicyManagementAPI *iampolicymanagement.IamPolicyManagementV1
+	iamPolicyManagementOnce sync.Once
+	iamPolicyManagementErr  error
+	iamPolicyManagementAPI  *iampolicymanagement.IamPolicyManagementV1
 
 	// IAM Access Groups
-	iamAccessGroupsErr error
-	iamAccessGroupsAPI *iamaccessgroups.IamAccessGroupsV2
+	iamAccessGroupsOnce sync.Once
+	iamAccessGroupsErr  error
+	iamAccessGroupsAPI  *iamaccessgroups.IamAccessGroupsV2
 
 	// MTLS Session options
+	cisMtlsOnce   sync.Once
 	cisMtlsClient *cismtlsv1.MtlsV1
 	cisMtlsErr    error
 
 	// Bot Management options
+	cisBotManagementOnce   sync.Once
 	cisBotManagementClient *cisbotmanagementv1.BotManagementV1
 	cisBotManagementErr    error
 
 	// Bot Analytics options
+	cisBotAnalyticsOnce   sync.Once
 	cisBotAnalyticsClient *cisbotanalyticsv1.BotAnalyticsV1
 	cisBotAnalyticsErr    error
 
 	// CIS Webhooks options
+	cisWebhooksOnce   sync.Once
 	cisWebhooksClient *ciswebhooksv1.WebhooksV1
 	cisWebhooksErr    error
 
 	// CIS Filters options
+	cisFiltersOnce   sync.Once
 	cisFiltersClient *cisfiltersv1.FiltersV1
 	cisFiltersErr    error
 
 	// CIS FirewallRules options
+	cisFirewallRulesOnce   sync.Once
 	cisFirewallRulesClient *cisfirewallrulesv1.FirewallRulesV1
 	cisFirewallRulesErr    error
 
 	// Atracker
+	atrackerOnce        sync.Once
 	atrackerClientV2    *atrackerv2.AtrackerV2
 	atrackerClientV2Err error
 
 	// Metrics Router
+	metricsRouterOnce      sync.Once
 	metricsRouterClient    *metricsrouterv3.MetricsRouterV3
 	metricsRouterClientErr error
 
 	// Satellite link service
+	satelliteLinkOnce      sync.Once
 	satelliteLinkClient    *satellitelinkv1.SatelliteLinkV1
 	satelliteLinkClientErr error
 
+	esSchemaRegistryOnce   sync.Once
 	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
 	esSchemaRegistryErr    error
 
+	esAdminRestOnce   sync.Once
 	esAdminRestClient *adminrestv1.AdminrestV1
 	esAdminRestErr    error
 
 	// Security and Compliance Center (SCC)
+	sccOnce                              sync.Once
 	securityAndComplianceCenterClient    *scc.SecurityAndComplianceCenterApiV3
 	securityAndComplianceCenterClientErr error
 
 	// context Based Restrictions (CBR)
+	contextBasedRestrictionsOnce      sync.Once
 	contextBasedRestrictionsClient    *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
 	contextBasedRestrictionsClientErr error
 
 	// CD Toolchain
+	cdToolchainOnce      sync.Once
 	cdToolchainClient    *cdtoolchainv2.CdToolchainV2
 	cdToolchainClientErr error
 
 	// CD Tekton Pipeline
+	cdTektonPipelineOnce      sync.Once
 	cdTektonPipelineClient    *cdtektonpipelinev2.CdTektonPipelineV2
 	cdTektonPipelineClientErr error
 
 	// Code Engine options
+	codeEngineOnce      sync.Once
 	codeEngineClient    *codeengine.CodeEngineV2
 	codeEngineClientErr error
 
 	// Project options
+	projectOnce      sync.Once
 	projectClient    *project.ProjectV1
 	projectClientErr error
 
 	// Usage Reports options
+	usageReportsOnce      sync.Once
 	usageReportsClient    *usagereportsv4.UsageReportsV4
 	usageReportsClientErr error
 
+	mqcloudOnce      sync.Once
 	mqcloudClient    *mqcloudv1.MqcloudV1
 	mqcloudClientErr error
 
 	// VMware Cloud Foundation as a Service
+	vmwareOnce      sync.Once
 	vmwareClient    *vmwarev1.VmwareV1
 	vmwareClientErr error
 
 	// Cloud Logs
+	logsOnce      sync.Once
 	logsClient    *logsv0.LogsV0
 	logsClientErr error
 
 	// Logs Routing
+	logsRoutingOnce              sync.Once
 	ibmCloudLogsRoutingClient    *ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0
 	ibmCloudLogsRoutingClientErr error
 
 	// db2 saas
+	db2saasOnce      sync.Once
 	db2saasClient    *db2saasv1.Db2saasV1
 	db2saasClientErr error
 
 	// Software Defined Storage
+	sdsaasOnce      sync.Once
 	sdsaasClient    *sdsaasv1.SdsaasV1
 	sdsaasClientErr error
 
 	// Global Catalog Management Option
+	globalCatalogOnce      sync.Once
 	globalCatalogClient    *globalcatalogv1.GlobalCatalogV1
 	globalCatalogClientErr error
 }
 
 // Usage Reports
-func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+func (session *clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	session.initOnce(&session.usageReportsOnce, session.initUsageReports)
 	return session.usageReportsClient, session.usageReportsClientErr
 }
 
-func (session clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+func (session *clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+	session.initOnce(&session.partnerCenterSellOnce, session.initPartnerCenterSell)
 	return session.partnerCenterSellClient, session.partnerCenterSellClientErr
 }
 
 // Configuration Aggregator
-func (session clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+func (session *clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+	session.initOnce(&session.configurationAggregatorOnce, session.initConfigurationAggregator)
 	return session.configurationAggregatorClient, session.configurationAggregatorClientErr
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.initOnce(&session.appIDOnce, session.initAppID)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.initOnce(&session.catalogManagementOnce, session.initCatalogManagement)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.initOnce(&sess.accountV2Once, sess.initAccountV2)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.initOnce(&sess.accountV1Once, sess.initAccountV1)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.initOnce(&sess.containerOnce, sess.initContainer)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.initOnce(&sess.vpcContainerOnce, sess.initVpcContainer)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.initOnce(&session.containerRegistryOnce, session.initContainerRegistry)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.initOnce(&sess.schematicsOnce, sess.initSchematics)
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -763,127 +931,152 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.initOnce(&sess.functionOnce, sess.initFunction)
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.initOnce(&sess.globalSearchOnce, sess.initGlobalSearch)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.initOnce(&sess.globalTaggingOnce, sess.initGlobalTagging)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.initOnce(&sess.globalTaggingV1Once, sess.initGlobalTaggingV1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.initOnce(&sess.globalSearchV2Once, sess.initGlobalSearchV2)
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.initOnce(&sess.hpcsOnce, sess.initHpcs)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.initOnce(&session.ukoOnce, session.initUko)
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.initOnce(&sess.userManagementOnce, sess.initUserManagement)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.initOnce(&sess.iamPolicyManagementOnce, sess.initIamPolicyManagement)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.initOnce(&sess.iamAccessGroupsOnce, sess.initIamAccessGroups)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.initOnce(&session.cloudShellOnce, session.initCloudShell)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.initOnce(&sess.icdOnce, sess.initIcd)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.initOnce(&session.cloudDatabasesOnce, session.initCloudDatabases)
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // IBM Db2 SaaS on Cloud REST API
-func (session clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+func (session *clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+	session.initOnce(&session.db2saasOnce, session.initDb2saas)
 	return session.db2saasClient, session.db2saasClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.initOnce(&sess.mccpOnce, sess.initMccp)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.initOnce(&sess.resourceCatalogOnce, sess.initResourceCatalog)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.initOnce(&sess.resourceManagementV2Once, sess.initResourceManagementV2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.initOnce(&sess.bmxResourceControllerOnce, sess.initBmxResourceController)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.initOnce(&sess.bmxResourceControllerV2Once, sess.initBmxResourceControllerV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
 // apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.initOnce(&sess.apiGatewayOnce, sess.initApiGateway)
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.initOnce(&session.pushServiceOnce, session.initPushService)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.initOnce(&session.eventNotificationsOnce, session.initEventNotifications)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.initOnce(&session.appConfigurationOnce, session.initAppConfiguration)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.initOnce(&sess.keyProtectOnce, sess.initKeyProtect)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.initOnce(&sess.keyManagementOnce, sess.initKeyManagement)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -902,7 +1095,7 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.config.wrapTransport(DefaultTransport()))
 		if err != nil {
 			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
@@ -911,50 +1104,60 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.initOnce(&sess.vpcOnce, sess.initVpc)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+func (sess *clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+	sess.initOnce(&sess.vpcBetaOnce, sess.initVpcBeta)
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.initOnce(&sess.directLinkOnce, sess.initDirectLink)
 	return sess.directlinkAPI, sess.directlinkErr
 }
 
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.initOnce(&sess.directLinkProviderOnce, sess.initDirectLinkProvider)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
 
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.initOnce(&sess.cosConfigOnce, sess.initCosConfig)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.initOnce(&sess.transitGatewayOnce, sess.initTransitGateway)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.initOnce(&sess.ibmPIOnce, sess.initIbmPI)
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.initOnce(&sess.privateDNSOnce, sess.initPrivateDNS)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.initOnce(&sess.functionIAMNamespaceOnce, sess.initFunctionIAMNamespace)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.initOnce(&sess.cisZonesOnce, sess.initCisZones)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -962,7 +1165,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.initOnce(&sess.cisDNSRecordsOnce, sess.initCisDNSRecords)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -970,7 +1174,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.initOnce(&sess.cisDNSRecordBulkOnce, sess.initCisDNSRecordBulk)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -978,7 +1183,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.initOnce(&sess.cisGLBPoolOnce, sess.initCisGLBPool)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -986,7 +1192,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.initOnce(&sess.cisGLBOnce, sess.initCisGLB)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -994,7 +1201,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.initOnce(&sess.cisGLBHealthCheckOnce, sess.initCisGLBHealthCheck)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -1002,7 +1210,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.initOnce(&sess.cisRLOnce, sess.initCisRL)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -1010,7 +1219,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.initOnce(&sess.cisIPOnce, sess.initCisIP)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -1018,7 +1228,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.initOnce(&sess.cisPageRuleOnce, sess.initCisPageRule)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -1026,7 +1237,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.initOnce(&sess.cisEdgeFunctionOnce, sess.initCisEdgeFunction)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -1034,7 +1246,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.initOnce(&sess.cisSSLOnce, sess.initCisSSL)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -1042,7 +1255,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.initOnce(&sess.cisWAFPackageOnce, sess.initCisWAFPackage)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -1050,7 +1264,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.initOnce(&sess.cisDomainSettingsOnce, sess.initCisDomainSettings)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -1058,7 +1273,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.initOnce(&sess.cisAlertsOnce, sess.initCisAlerts)
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -1066,7 +1282,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Rulesets
-func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+func (sess *clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+	sess.initOnce(&sess.cisRulesetsOnce, sess.initCisRulesets)
 	if sess.cisRulesetsErr != nil {
 		return sess.cisRulesetsClient, sess.cisRulesetsErr
 	}
@@ -1074,7 +1291,8 @@ func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.initOnce(&sess.cisRoutingOnce, sess.initCisRouting)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -1082,7 +1300,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.initOnce(&sess.cisWAFGroupOnce, sess.initCisWAFGroup)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -1090,7 +1309,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.initOnce(&sess.cisCacheOnce, sess.initCisCache)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -1098,7 +1318,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.initOnce(&sess.cisCustomPageOnce, sess.initCisCustomPage)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -1106,7 +1327,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.initOnce(&sess.cisAccessRuleOnce, sess.initCisAccessRule)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1114,7 +1336,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.initOnce(&sess.cisUARuleOnce, sess.initCisUARule)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1122,7 +1345,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.initOnce(&sess.cisLockdownOnce, sess.initCisLockdown)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1130,7 +1354,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.initOnce(&sess.cisRangeAppOnce, sess.initCisRangeApp)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1138,7 +1363,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.initOnce(&sess.cisWAFRuleOnce, sess.initCisWAFRule)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1146,7 +1372,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.initOnce(&sess.cisOriginAuthOnce, sess.initCisOriginAuth)
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1154,47 +1381,56 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.initOnce(&sess.iamIdentityOnce, sess.initIamIdentity)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.initOnce(&sess.resourceManagerOnce, sess.initResourceManager)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.initOnce(&session.enterpriseManagementOnce, session.initEnterpriseManagement)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.initOnce(&sess.resourceControllerOnce, sess.initResourceController)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
-func (session clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+func (session *clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+	session.initOnce(&session.backupRecoveryOnce, session.initBackupRecovery)
 	return session.backupRecoveryClient, session.backupRecoveryClientErr
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
-func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+func (session *clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	session.initOnce(&session.secretsManagerOnce, session.initSecretsManager)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.initOnce(&session.satelliteLinkOnce, session.initSatelliteLink)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.initOnce(&sess.satelliteOnce, sess.initSatellite)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.initOnce(&sess.cisLogpushJobsOnce, sess.initCisLogpushJobs)
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1202,7 +1438,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.initOnce(&sess.cisMtlsOnce, sess.initCisMtls)
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1210,7 +1447,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Bot Management
-func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+func (sess *clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+	sess.initOnce(&sess.cisBotManagementOnce, sess.initCisBotManagement)
 	if sess.cisBotManagementErr != nil {
 		return sess.cisBotManagementClient, sess.cisBotManagementErr
 	}
@@ -1218,7 +1456,8 @@ func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotMana
 }
 
 // CIS Bot Analytics
-func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+func (sess *clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+	sess.initOnce(&sess.cisBotAnalyticsOnce, sess.initCisBotAnalytics)
 	if sess.cisBotAnalyticsErr != nil {
 		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
 	}
@@ -1226,7 +1465,8 @@ func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyt
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.initOnce(&sess.cisWebhooksOnce, sess.initCisWebhooks)
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1234,7 +1474,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.initOnce(&sess.cisFiltersOnce, sess.initCisFilters)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1242,7 +1483,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.initOnce(&sess.cisFirewallRulesOnce, sess.initCisFirewallRules)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1250,55 +1492,66 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.initOnce(&session.atrackerOnce, session.initAtracker)
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
 // Metrics Router API Version 3
-func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+func (session *clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+	session.initOnce(&session.metricsRouterOnce, session.initMetricsRouter)
 	return session.metricsRouterClient, session.metricsRouterClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.initOnce(&session.esSchemaRegistryOnce, session.initEsSchemaRegistry)
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
-func (session clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+func (session *clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+	session.initOnce(&session.esAdminRestOnce, session.initEsAdminRest)
 	return session.esAdminRestClient, session.esAdminRestErr
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+func (session *clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+	session.initOnce(&session.sccOnce, session.initScc)
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.initOnce(&session.contextBasedRestrictionsOnce, session.initContextBasedRestrictions)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.initOnce(&session.cdToolchainOnce, session.initCdToolchain)
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.initOnce(&session.cdTektonPipelineOnce, session.initCdTektonPipeline)
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // Code Engine
-func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+func (session *clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+	session.initOnce(&session.codeEngineOnce, session.initCodeEngine)
 	return session.codeEngineClient, session.codeEngineClientErr
 }
 
 // Projects API Specification
-func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
+func (session *clientSession) ProjectV1() (*project.ProjectV1, error) {
+	session.initOnce(&session.projectOnce, session.initProject)
 	return session.projectClient, session.projectClientErr
 }
 
 // MQaaS
-func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+func (session *clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+	session.initOnce(&session.mqcloudOnce, session.initMqcloud)
 	if session.mqcloudClientErr != nil {
 		sessionMqcloudClient := session.mqcloudClient
 		sessionMqcloudClient.EnableRetries(0, 0)
@@ -1308,39 +1561,68 @@ func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
 }
 
 // sdsaas
-func (session clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+func (session *clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+	session.initOnce(&session.sdsaasOnce, session.initSdsaas)
 	return session.sdsaasClient, session.sdsaasClientErr
 }
 
 // VMware as a Service API
-func (session clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+func (session *clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+	session.initOnce(&session.vmwareOnce, session.initVmware)
 	return session.vmwareClient, session.vmwareClientErr
 }
 
 // Cloud Logs
-func (session clientSession) LogsV0() (*logsv0.LogsV0, error) {
+func (session *clientSession) LogsV0() (*logsv0.LogsV0, error) {
+	session.initOnce(&session.logsOnce, session.initLogs)
 	return session.logsClient, session.logsClientErr
 }
 
 // IBM Cloud Logs Routing
-func (session clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+func (session *clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+	session.initOnce(&session.logsRoutingOnce, session.initLogsRouting)
 	return session.ibmCloudLogsRoutingClient, session.ibmCloudLogsRoutingClientErr
 }
 
 // GlobalCatalog Session
-func (sess clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+func (sess *clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+	sess.initOnce(&sess.globalCatalogOnce, sess.initGlobalCatalog)
 	return sess.globalCatalogClient, sess.globalCatalogClientErr
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// ClientSession authenticates and returns a ClientSession. The service clients
+// are constructed lazily, the first time their accessor is called.
 func (c *Config) ClientSession() (interface{}, error) {
+	c.rateLimiter = newRateLimiter(c)
+	if c.TraceFile != "" {
+		if err := startTrace(c.TraceFile); err != nil {
+			return nil, err
+		}
+	}
+	if c.DriftReportFile != "" {
+		if err := startDriftReport(c.DriftReportFile); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.configureAuthType(); err != nil {
+		return nil, err
+	}
+	if err := c.configureAssumeTrustedProfile(); err != nil {
+		return nil, err
+	}
+	endpointsFile, err := c.endpointsFile()
+	if err != nil {
+		return nil, err
+	}
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:       sess,
+		config:        c,
+		endpointsFile: endpointsFile,
 	}
 
 	if sess.BluemixSession == nil {
@@ -1431,7 +1713,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.logsClientErr = errEmptyBluemixCredentials
 		session.ibmCloudLogsRoutingClientErr = errEmptyBluemixCredentials
 
-		return session, nil
+		return &session, nil
 	}
 
 	if sess.BluemixSession.Config.BluemixAPIKey != "" {
@@ -1441,7 +1723,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				if err == nil || !isRetryable(err) {
 					break
 				}
-				time.Sleep(c.RetryDelay)
+				time.Sleep(Backoff(c.RetryCount-count, c.RetryDelay))
 				log.Printf("Retrying IAM Authentication %d", count)
 				err = authenticateAPIKey(sess.BluemixSession)
 			}
@@ -1454,14 +1736,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 	}
 
-	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
+	if c.IAMTrustedProfileID == "" && c.authenticator == nil && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
 		err := RefreshToken(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
 				if err == nil || !isRetryable(err) {
 					break
 				}
-				time.Sleep(c.RetryDelay)
+				time.Sleep(Backoff(c.RetryCount-count, c.RetryDelay))
 				log.Printf("Retrying refresh token %d", count)
 				err = RefreshToken(sess.BluemixSession)
 			}
@@ -1482,68 +1764,148 @@ func (c *Config) ClientSession() (interface{}, error) {
 		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
 	}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
-
 	BluemixRegion = sess.BluemixSession.Config.Region
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
+	iamURL := session.endpoint("IBMCLOUD_IAM_API_ENDPOINT", c.iamEndpoint())
+
+	var authenticator core.Authenticator
+
+	if c.authenticator != nil {
+		authenticator = c.authenticator
+	} else if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+		if c.BluemixAPIKey != "" {
+			authenticator = &core.IamAuthenticator{
+				ApiKey: c.BluemixAPIKey,
+				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			}
+		} else {
+			// Construct the IamAuthenticator with the IAM refresh token.
+			authenticator = &core.IamAuthenticator{
+				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
+				ClientId:     "bx",
+				ClientSecret: "bx",
+				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			}
 		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
+	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
 		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+	} else {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
+		}
+	}
+
+	session.config = c
+	session.iamURL = iamURL
+	session.authenticator = authenticator
+
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+
+	// setting UserAgent for vpc-go-sdk common
+	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
+	return &session, nil
+}
+
+// initOnce constructs a service client the first time one of its accessors is
+// called. Nothing is built when no IBM Cloud credentials were configured, so the
+// accessors keep returning the error recorded by ClientSession.
+func (session *clientSession) initOnce(once *sync.Once, init func()) {
+	once.Do(func() {
+		if session.session.BluemixSession != nil {
+			init()
 		}
+	})
+}
+
+// cisEndpoint is shared by all the CIS service clients.
+func (session *clientSession) cisEndpoint() string {
+	cisURL := session.endpoint("IBMCLOUD_CIS_API_ENDPOINT", ContructEndpoint("api.cis", cloudEndpoint))
+	return EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+}
+
+// vpcEndpoint is shared by the VPC and VPC beta clients.
+func (session *clientSession) vpcEndpoint() string {
+	c := session.config
+	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
+	vpcurl = session.endpoint("IBMCLOUD_IS_NG_API_ENDPOINT", vpcurl)
+	return vpcurl
+}
+
+func (session *clientSession) initFunction() {
+	sess := session.session
+	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+}
+
+func (session *clientSession) initAccountV1() {
+	sess := session.session
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
 
+func (session *clientSession) initAccountV2() {
+	sess := session.session
 	accAPI, err := accountv2.New(sess.BluemixSession)
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
 
+func (session *clientSession) initMccp() {
+	sess := session.session
 	cfAPI, err := mccpv2.New(sess.BluemixSession)
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
 
+func (session *clientSession) initContainer() {
+	sess := session.session
 	clusterAPI, err := containerv1.New(sess.BluemixSession)
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
 
+func (session *clientSession) initVpcContainer() {
+	sess := session.session
 	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
 
+func (session *clientSession) initHpcs() {
+	sess := session.session
 	hpcsAPI, err := hpcs.New(sess.BluemixSession)
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
 
+func (session *clientSession) initKeyProtect() {
+	c := session.config
+	sess := session.session
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
+	kpurl = session.endpoint("IBMCLOUD_KP_API_ENDPOINT", kpurl)
 	var options kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
@@ -1560,32 +1922,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, c.wrapTransport(DefaultTransport()))
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
-
+func (session *clientSession) initKeyManagement() {
 	// KEY MANAGEMENT Service
+	c := session.config
+	sess := session.session
+	iamURL := session.iamURL
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
+	kmsurl = session.endpoint("IBMCLOUD_KP_API_ENDPOINT", kmsurl)
 	var kmsOptions kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
@@ -1604,45 +1957,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, c.wrapTransport(DefaultTransport()))
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
-
+func (session *clientSession) initBackupRecovery() {
 	// Construct the service options.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var backupRecoveryURL string
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		backupRecoveryURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_ENDPOINT", c.Region, backupRecoveryURL)
-	}
+	backupRecoveryURL = session.endpoint("IBMCLOUD_BACKUP_RECOVERY_ENDPOINT", backupRecoveryURL)
 
 	backupRecoveryClientOptions := &backuprecoveryv1.BackupRecoveryV1Options{
 		Authenticator: authenticator,
@@ -1656,6 +1985,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.backupRecoveryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.backupRecoveryClient.Service)
 		// Add custom header for analytics
 		session.backupRecoveryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1663,12 +1993,15 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.backupRecoveryClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
 	}
+}
 
+func (session *clientSession) initProject() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	projectEndpoint := project.DefaultServiceURL
 	// Construct an "options" struct for creating the service client.
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
-	}
+	projectEndpoint = session.endpoint("IBMCLOUD_PROJECT_API_ENDPOINT", project.DefaultServiceURL)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		session.projectClientErr = fmt.Errorf("Project Service API does not support private endpoints")
 	}
@@ -1683,6 +2016,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.projectClient.Service)
 		// Add custom header for analytics
 		session.projectClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1690,16 +2024,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
 	}
+}
 
+func (session *clientSession) initLogs() {
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		logsEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.logs", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_API_ENDPOINT", c.Region, logsEndpoint)
-	}
+	logsEndpoint = session.endpoint("IBMCLOUD_LOGS_API_ENDPOINT", logsEndpoint)
 	logsClientOptions := &logsv0.LogsV0Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_LOGS_API_ENDPOINT"}, logsEndpoint),
@@ -1710,6 +2047,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.logsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.logsClient.Service)
 		// Add custom header for analytics
 		session.logsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1717,14 +2055,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
 	}
+}
 
+func (session *clientSession) initLogsRouting() {
 	// LOGS ROUTER Version 0
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var logsrouterClientURL string
 	var logsrouterURLErr error
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsrouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", c.Region, ibmcloudlogsroutingv0.DefaultServiceURL)
-	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion("private." + c.Region)
 	} else {
 		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion(c.Region)
@@ -1732,6 +2073,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if logsrouterURLErr != nil {
 		logsrouterClientURL = ibmcloudlogsroutingv0.DefaultServiceURL
 	}
+	logsrouterClientURL = session.endpoint("IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", logsrouterClientURL)
 	ibmCloudLogsRoutingClientOptions := &ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0Options{
 		Authenticator: authenticator,
 		URL:           logsrouterClientURL,
@@ -1742,6 +2084,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.ibmCloudLogsRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.ibmCloudLogsRoutingClient.Service)
 		// Add custom header for analytics
 		session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1749,8 +2092,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
 	}
+}
 
+func (session *clientSession) initUko() {
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	ukoClientOptions := &ukov4.UkoV4Options{
 		Authenticator: authenticator,
 	}
@@ -1760,6 +2108,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.ukoClient.Service)
 		// Add custom header for analytics
 		session.ukoClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1767,15 +2116,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
 	}
+}
 
+func (session *clientSession) initAppID() {
 	// APP ID Service
+	c := session.config
+	authenticator := session.authenticator
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
+	appIDEndpoint = session.endpoint("IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", appIDEndpoint)
 	appIDClientOptions := &appid.AppIDManagementV4Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
@@ -1786,13 +2137,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
 		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(appIDClient.Service)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
 
+func (session *clientSession) initContextBasedRestrictions() {
 	// Construct an "options" struct for creating Context Based Restrictions service client.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-de" {
@@ -1801,9 +2158,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
+	cbrURL = session.endpoint("IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", cbrURL)
 	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
@@ -1814,6 +2169,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
 		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.contextBasedRestrictionsClient.Service)
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1821,15 +2177,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
 
+func (session *clientSession) initPartnerCenterSell() {
 	// PARTNER CENTER SELL (product lifecycle) service
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	partnerCenterSellURL := "https://product-lifecycle.api.cloud.ibm.com/openapi/v1"
 	if c.Visibility == "private" {
 		session.partnerCenterSellClientErr = fmt.Errorf("partner center sell does not support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		partnerCenterSellURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", c.Region, partnerCenterSellURL)
-	}
+	partnerCenterSellURL = session.endpoint("IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", partnerCenterSellURL)
 	partnerCenterSellClientOptions := &partnercentersellv1.PartnerCenterSellV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT"}, partnerCenterSellURL),
 		Authenticator: authenticator,
@@ -1842,13 +2201,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
 		// Enable retries for API calls
 		session.partnerCenterSellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.partnerCenterSellClient.Service)
 		// Add custom header for analytics
 		session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initUsageReports() {
 	//Usage Reports Service Client
+	c := session.config
+	authenticator := session.authenticator
 	usageReportsURL := usagereportsv4.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1866,9 +2230,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			usageReportsURL = usagereportsv4.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
-	}
+	usageReportsURL = session.endpoint("IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", usageReportsURL)
 	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
@@ -1879,20 +2241,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if usageReportsClient != nil && usageReportsClient.Service != nil {
 		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(usageReportsClient.Service)
 		usageReportsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.usageReportsClient = usageReportsClient
+}
 
+func (session *clientSession) initCatalogManagement() {
 	// CATALOG MANAGEMENT Service
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
+	catalogManagementURL = session.endpoint("IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", catalogManagementURL)
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
 		Authenticator: authenticator,
@@ -1905,19 +2271,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
 		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initAtracker() {
 	// ATRACKER Version 2
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var atrackerClientV2URL string
 	var atrackerURLV2Err error
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+		if atrackerURLV2Err != nil && c.Visibility == "public-and-private" {
 			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
 		}
 	} else {
@@ -1926,9 +2298,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if atrackerURLV2Err != nil {
 		atrackerClientV2URL = atrackerv2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
-	}
+	atrackerClientV2URL = session.endpoint("IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientV2URL)
 	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
@@ -1942,6 +2312,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.atrackerClientV2.Service)
 		// Add custom header for analytics
 		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1949,8 +2320,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
 	}
+}
 
+func (session *clientSession) initMetricsRouter() {
 	// Construct an "options" struct for creating the service client for Metrics Router
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var metricsRouterClientURL string
 	var metricsRouterURLV3Err error
 
@@ -1965,9 +2341,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if metricsRouterURLV3Err != nil {
 		metricsRouterClientURL = metricsrouterv3.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
-	}
+	metricsRouterClientURL = session.endpoint("IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", metricsRouterClientURL)
 	metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
@@ -1978,6 +2352,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.metricsRouterClient.Service)
 		// Add custom header for analytics
 		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1985,8 +2360,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
 	}
+}
 
+func (session *clientSession) initScc() {
 	// SCC (Security and Compliance Center) Service
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	sccApiClientURL := scc.DefaultServiceURL
 	// Construct the service options.
 	if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
@@ -2002,6 +2382,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.securityAndComplianceCenterClient.Service)
 		// Add custom header for analytics
 		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2009,16 +2390,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
 	}
+}
 
+func (session *clientSession) initSchematics() {
 	// SCHEMATICS Service
 	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
+	c := session.config
+	authenticator := session.authenticator
 	schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
+	schematicsEndpoint = session.endpoint("IBMCLOUD_SCHEMATICS_API_ENDPOINT", schematicsEndpoint)
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
@@ -2031,20 +2414,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
 		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(schematicsClient.Service)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
 
+func (session *clientSession) initVpc() {
 	// VPC Service
-	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
+	c := session.config
+	authenticator := session.authenticator
+	vpcurl := session.vpcEndpoint()
 	vpcoptions := &vpc.VpcV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
 		Authenticator: authenticator,
@@ -2055,12 +2437,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
 		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(vpcclient.Service)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcAPI = vpcclient
+}
 
+func (session *clientSession) initVpcBeta() {
+	c := session.config
+	authenticator := session.authenticator
+	vpcurl := session.vpcEndpoint()
 	vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
 		Authenticator: authenticator,
@@ -2071,20 +2459,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
 		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(vpcbetaclient.Service)
 		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcBetaAPI = vpcbetaclient
+}
 
+func (session *clientSession) initPushService() {
 	// PUSH NOTIFICATIONS Service
+	c := session.config
+	authenticator := session.authenticator
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
+	pnurl = session.endpoint("IBMCLOUD_PUSH_API_ENDPOINT", pnurl)
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
 		Authenticator: authenticator,
@@ -2096,22 +2487,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
 		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(pnclient.Service)
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
+}
 
+func (session *clientSession) initEventNotifications() {
 	// event notifications
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		enurl = fmt.Sprintf("https://private.%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
+	enurl = session.endpoint("IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", enurl)
 	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
@@ -2125,19 +2520,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
 		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.eventNotificationsApiClient.Service)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initAppConfiguration() {
 	// APP CONFIGURATION Service
+	c := session.config
+	authenticator := session.authenticator
 	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
-	}
+	appconfigurl = session.endpoint("IBMCLOUD_APP_CONFIG_ENDPOINT", appconfigurl)
 	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
 		Authenticator: authenticator,
@@ -2147,13 +2545,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if appConfigClient != nil {
 		// Enable retries for API calls
 		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
 
+func (session *clientSession) initContainerRegistry() {
 	// CONTAINER REGISTRY Service
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
 		containerRegistryClientURL = containerregistryv1.DefaultServiceURL
@@ -2164,9 +2568,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
+	containerRegistryClientURL = session.endpoint("IBMCLOUD_CR_API_ENDPOINT", containerRegistryClientURL)
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
@@ -2180,17 +2582,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
 		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCosConfig() {
 	// OBJECT STORAGE Service
+	c := session.config
+	authenticator := session.authenticator
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
-	}
+	cosconfigurl = session.endpoint("IBMCLOUD_COS_CONFIG_ENDPOINT", cosconfigurl)
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
@@ -2199,21 +2604,35 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 	}
+	if cosconfigclient != nil {
+		c.wrapServiceTransport(cosconfigclient.Service)
+	}
 	session.cosConfigAPI = cosconfigclient
+}
 
+func (session *clientSession) initGlobalSearch() {
+	sess := session.session
 	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
+}
+
+func (session *clientSession) initGlobalTagging() {
 	// Global Tagging Bluemix-go
+	sess := session.session
 	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
 
+func (session *clientSession) initGlobalTaggingV1() {
 	// GLOBAL TAGGING Service
+	c := session.config
+	authenticator := session.authenticator
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalTaggingRegion string
@@ -2224,9 +2643,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
+	globalTaggingEndpoint = session.endpoint("IBMCLOUD_GT_API_ENDPOINT", globalTaggingEndpoint)
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
 		Authenticator: authenticator,
@@ -2238,11 +2655,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
 		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.globalTaggingServiceAPIV1.Service)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) initGlobalSearchV2() {
 	// GLOBAL TAGGING Service
+	c := session.config
+	authenticator := session.authenticator
 	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalSearchRegion string
@@ -2253,31 +2676,38 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalSearchEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s", globalSearchRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
-	}
+	globalSearchEndpoint = session.endpoint("IBMCLOUD_GS_API_ENDPOINT", searchv2.DefaultServiceURL)
 	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
 		Authenticator: authenticator,
 	}
 	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
 	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
 		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
 		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.globalSearchServiceAPIV2.Service)
 		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initIcd() {
+	sess := session.session
 	icdAPI, err := icdv4.New(sess.BluemixSession)
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
 
+func (session *clientSession) initCloudDatabases() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var cloudDatabasesEndpoint string
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2297,6 +2727,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cloudDatabasesClient.Service)
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2304,51 +2735,70 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
 	}
+}
 
+func (session *clientSession) initResourceCatalog() {
+	sess := session.session
 	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
 
+func (session *clientSession) initResourceManagementV2() {
+	sess := session.session
 	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
 
+func (session *clientSession) initBmxResourceController() {
+	sess := session.session
 	resourceControllerAPI, err := controller.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
 
+func (session *clientSession) initBmxResourceControllerV2() {
+	sess := session.session
 	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
 
+func (session *clientSession) initUserManagement() {
+	sess := session.session
 	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
 
+func (session *clientSession) initFunctionIAMNamespace() {
+	sess := session.session
 	namespaceFunction, err := functions.New(sess.BluemixSession)
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
 
+func (session *clientSession) initApiGateway() {
 	//  API GATEWAY service
+	c := session.config
 	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
-	}
+	apicurl = session.endpoint("IBMCLOUD_API_GATEWAY_ENDPOINT", apicurl)
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
@@ -2357,9 +2807,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
 	}
+	if apigatewayAPI != nil && apigatewayAPI.Service != nil {
+		apigatewayAPI.Service.Client = c.wrapHTTPClient(apigatewayAPI.Service.Client)
+	}
 	session.apigatewayAPI = apigatewayAPI
+}
 
+func (session *clientSession) initIbmPI() {
 	// POWER SYSTEMS Service
+	c := session.config
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
@@ -2373,16 +2831,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
 	}
+	if ibmpisession != nil && ibmpisession.Power != nil {
+		c.wrapRuntimeTransport(ibmpisession.Power.Transport)
+	}
 	session.ibmpiSession = ibmpisession
+}
 
+func (session *clientSession) initPrivateDNS() {
 	// PRIVATE DNS Service
+	c := session.config
+	authenticator := session.authenticator
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
+	pdnsURL = session.endpoint("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", pdnsURL)
 	dnsOptions := &dns.DnsSvcsV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
 		Authenticator: authenticator,
@@ -2393,20 +2856,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
 		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.pDNSClient.Service)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initDirectLink() {
 	// DIRECT LINK Service
+	c := session.config
+	authenticator := session.authenticator
 	ver := time.Now().Format("2006-01-02")
 	dlURL := dl.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
+	dlURL = session.endpoint("IBMCLOUD_DL_API_ENDPOINT", dlURL)
 	directlinkOptions := &dl.DirectLinkV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
 		Authenticator: authenticator,
@@ -2418,19 +2884,23 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
 		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.directlinkAPI.Service)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initDirectLinkProvider() {
 	// DIRECT LINK PROVIDER Service
+	c := session.config
+	authenticator := session.authenticator
+	ver := time.Now().Format("2006-01-02")
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
+	dlproviderURL = session.endpoint("IBMCLOUD_DL_PROVIDER_API_ENDPOINT", dlproviderURL)
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
 		Authenticator: authenticator,
@@ -2442,19 +2912,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
 		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.dlProviderAPI.Service)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initTransitGateway() {
 	// TRANSIT GATEWAY Service
+	c := session.config
+	authenticator := session.authenticator
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
+	tgURL = session.endpoint("IBMCLOUD_TG_API_ENDPOINT", tgURL)
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
 		Authenticator: authenticator,
@@ -2466,12 +2939,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
 		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.transitgatewayAPI.Service)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
 
+func (session *clientSession) initConfigurationAggregator() {
 	// Construct an instance of the 'Configuration Aggregator' service.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var configBaseURL string
 	configBaseURL = ContructEndpoint(fmt.Sprintf("%s.apprapp", c.Region), cloudEndpoint)
 
@@ -2485,6 +2964,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.configurationAggregatorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.configurationAggregatorClient.Service)
 		// Add custom header for analytics
 		session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2492,8 +2972,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
 	}
+}
 
+func (session *clientSession) initDb2saas() {
 	// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	if session.db2saasClientErr == nil {
 		// Construct the service options.
 		defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
@@ -2507,6 +2992,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		if err == nil {
 			// Enable retries for API calls
 			session.db2saasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.wrapServiceTransport(session.db2saasClient.Service)
 			// Add custom header for analytics
 			session.db2saasClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2515,43 +3001,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
 		}
 	}
+}
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
-
+func (session *clientSession) initCisZones() {
 	// IBM Network CIS Zones service
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2565,12 +3021,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
 		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisZonesV1Client.Service)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisDNSRecords() {
 	// IBM Network CIS DNS Record service
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2583,12 +3045,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
 		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisDNSRecordsClient.Service)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisDNSRecordBulk() {
 	// IBM Network CIS DNS Record bulk service
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2603,12 +3071,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
 		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisDNSRecordBulkClient.Service)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisGLBPool() {
 	// IBM Network CIS Global load balancer pool
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2621,12 +3095,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
 		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisGLBPoolClient.Service)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisGLB() {
 	// IBM Network CIS Global load balancer
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -2640,12 +3120,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
 		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisGLBClient.Service)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisGLBHealthCheck() {
 	// IBM Network CIS Global load balancer health check/monitor
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2658,12 +3144,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
 		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisGLBHealthCheckClient.Service)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisIP() {
 	// IBM Network CIS IP
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2675,12 +3167,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
 		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisIPClient.Service)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisRL() {
 	// IBM Network CIS Zone Rate Limit
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2695,11 +3193,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
 		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisRLClient.Service)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) initCisAlerts() {
 	// IBM Network CIS Alerts
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2712,12 +3217,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
 		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisAlertsClient.Service)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisRulesets() {
 	// IBM Network CIS Rulesets
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2731,12 +3242,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
 		session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisRulesetsClient.Service)
 		session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisPageRule() {
 	// IBM Network CIS Page Rules
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2751,12 +3268,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
 		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisPageRuleClient.Service)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisEdgeFunction() {
 	// IBM Network CIS Edge Function
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2770,12 +3293,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
 		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisEdgeFunctionClient.Service)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisSSL() {
 	// IBM Network CIS SSL certificate
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2790,12 +3319,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
 		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisSSLClient.Service)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisWAFPackage() {
 	// IBM Network CIS WAF Package
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2809,12 +3344,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
 		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisWAFPackageClient.Service)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisDomainSettings() {
 	// IBM Network CIS Domain settings
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2828,12 +3369,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
 		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisDomainSettingsClient.Service)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisRouting() {
 	// IBM Network CIS Routing
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2847,12 +3394,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
 		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisRoutingClient.Service)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisWAFGroup() {
 	// IBM Network CIS WAF Group
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2866,12 +3419,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
 		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisWAFGroupClient.Service)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisCache() {
 	// IBM Network CIS Cache service
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisCacheOpt := &ciscachev1.CachingApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2885,12 +3444,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
 		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisCacheClient.Service)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisCustomPage() {
 	// IBM Network CIS Custom pages service
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2905,12 +3470,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
 		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisCustomPageClient.Service)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisAccessRule() {
 	// IBM Network CIS Firewall Access rule
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2924,12 +3495,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
 		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisAccessRuleClient.Service)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisUARule() {
 	// IBM Network CIS Firewall User Agent Blocking rule
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2943,12 +3520,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
 		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisUARuleClient.Service)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisLockdown() {
 	// IBM Network CIS Firewall Lockdown rule
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2962,12 +3545,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
 		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisLockdownClient.Service)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisRangeApp() {
 	// IBM Network CIS Range Application rule
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2981,12 +3570,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
 		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisRangeAppClient.Service)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisWAFRule() {
 	// IBM Network CIS WAF Rule Service
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -3001,12 +3596,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
 		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisWAFRuleClient.Service)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisLogpushJobs() {
 	// IBM Network CIS LogpushJobs
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -3021,12 +3622,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
 		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisLogpushJobsClient.Service)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisMtls() {
 	// IBM MTLS Session
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -3039,12 +3646,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
 		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisMtlsClient.Service)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisBotManagement() {
 	// IBM Bot Management
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -3058,12 +3671,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
 		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisBotManagementClient.Service)
 		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisBotAnalytics() {
 	// IBM Bot Analytics
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -3077,12 +3696,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
 		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisBotAnalyticsClient.Service)
 		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisWebhooks() {
 	// IBM Network CIS Webhooks
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -3095,11 +3720,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
 		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisWebhooksClient.Service)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
+
+func (session *clientSession) initCisFilters() {
 	// IBM Network CIS Filters
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -3111,12 +3743,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
 		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisFiltersClient.Service)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisFirewallRules() {
 	// IBM Network CIS Firewall rules
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -3128,12 +3766,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
 		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisFirewallRulesClient.Service)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCisOriginAuth() {
 	// IBM Network CIS Authenticated Origin Pull
+	c := session.config
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndpoint()
 	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -3149,13 +3793,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
 		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cisOriginAuthClient.Service)
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initIamIdentity() {
 	// IAM IDENTITY Service
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+	c := session.config
+	authenticator := session.authenticator
 	iamIdenityURL := iamidentity.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3164,9 +3813,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
+	iamIdenityURL = session.endpoint("IBMCLOUD_IAM_API_ENDPOINT", iamIdenityURL)
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
@@ -3177,13 +3824,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
 		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(iamIdentityClient.Service)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
 
+func (session *clientSession) initIamPolicyManagement() {
 	// IAM POLICY MANAGEMENT Service
+	c := session.config
+	authenticator := session.authenticator
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3192,9 +3844,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
+	iamPolicyManagementURL = session.endpoint("IBMCLOUD_IAM_API_ENDPOINT", iamPolicyManagementURL)
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
@@ -3205,13 +3855,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
 		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(iamPolicyManagementClient.Service)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
 
+func (session *clientSession) initIamAccessGroups() {
 	// IAM ACCESS GROUP
+	c := session.config
+	authenticator := session.authenticator
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3220,9 +3875,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
+	iamAccessGroupsURL = session.endpoint("IBMCLOUD_IAM_API_ENDPOINT", iamAccessGroupsURL)
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
@@ -3233,13 +3886,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
 		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(iamAccessGroupsClient.Service)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
 
+func (session *clientSession) initResourceManager() {
 	// RESOURCE MANAGEMENT Service
+	c := session.config
+	authenticator := session.authenticator
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3256,9 +3914,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rmURL = resourcemanager.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
+	rmURL = session.endpoint("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", rmURL)
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
@@ -3269,17 +3925,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
 		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(resourceManagerClient.Service)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
 
+func (session *clientSession) initCloudShell() {
 	// CLOUD SHELL Service
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
-	}
+	cloudShellUrl = session.endpoint("IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", cloudShellUrl)
 	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
@@ -3290,12 +3950,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
 		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.ibmCloudShellClient.Service)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initEnterpriseManagement() {
 	// ENTERPRISE Service
+	c := session.config
+	authenticator := session.authenticator
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
@@ -3313,9 +3978,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
+	enterpriseURL = session.endpoint("IBMCLOUD_ENTERPRISE_API_ENDPOINT", enterpriseURL)
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
@@ -3326,13 +3989,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
 		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(enterpriseManagementClient.Service)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
 
+func (session *clientSession) initResourceController() {
 	// RESOURCE CONTROLLER Service
+	c := session.config
+	authenticator := session.authenticator
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3349,9 +4017,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rcURL = resourcecontroller.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
+	rcURL = session.endpoint("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", rcURL)
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
@@ -3362,14 +4028,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
 		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(resourceControllerClient.Service)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
 
+func (session *clientSession) initSecretsManager() {
 	// SECRETS MANAGER Service V2
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var smBaseUrl string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		smBaseUrl = ContructEndpoint(fmt.Sprintf("private.secrets-manager.%s", c.Region), cloudEndpoint)
@@ -3387,6 +4059,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3394,15 +4067,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
 	}
+}
 
+func (session *clientSession) initSatellite() {
 	// SATELLITE Service
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
+	containerEndpoint = session.endpoint("IBMCLOUD_SATELLITE_API_ENDPOINT", containerEndpoint)
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
 		Authenticator: authenticator,
@@ -3415,20 +4091,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
 		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.satelliteClient.Service)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initSatelliteLink() {
 	// SATELLITE LINK Service
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
+	satelliteLinkEndpoint = session.endpoint("IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", satelliteLinkEndpoint)
 	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
 		Authenticator: authenticator,
@@ -3440,12 +4120,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
 		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.satelliteLinkClient.Service)
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initEsSchemaRegistry() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
 		Authenticator: authenticator,
 	}
@@ -3455,11 +4141,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
 		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.esSchemaRegistryClient.Service)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initEsAdminRest() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
 		Authenticator: authenticator,
 	}
@@ -3469,12 +4161,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
 		session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.esAdminRestClient.Service)
 		session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) initCdToolchain() {
 	// Construct an "options" struct for creating the service client.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var cdToolchainClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
@@ -3487,9 +4185,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
-	}
+	cdToolchainClientURL = session.endpoint("IBMCLOUD_TOOLCHAIN_ENDPOINT", cdToolchainClientURL)
 	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
@@ -3500,6 +4196,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cdToolchainClient.Service)
 		// Add custom header for analytics
 		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3507,8 +4204,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
+}
 
+func (session *clientSession) initCdTektonPipeline() {
 	// Construct an "options" struct for creating the tekton pipeline service client.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var cdTektonPipelineClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
@@ -3521,9 +4223,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
-	}
+	cdTektonPipelineClientURL = session.endpoint("IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", cdTektonPipelineClientURL)
 	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
@@ -3533,6 +4233,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.cdTektonPipelineClient.Service)
 		// Add custom header for analytics
 		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3540,15 +4241,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 	}
+}
 
+func (session *clientSession) initMqcloud() {
 	// MQaaS Service Configuration
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
-	}
+	mqCloudURL = session.endpoint("IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", mqCloudURL)
 	accept_language := os.Getenv("IBMCLOUD_MQCLOUD_ACCEPT_LANGUAGE")
 	mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
 		Authenticator:  authenticator,
@@ -3561,6 +4265,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.mqcloudClient.Service)
 		// Add custom header for analytics
 		session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3568,9 +4273,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.mqcloudClientErr = fmt.Errorf("Error occurred while configuringMQaaS service: %q", err)
 	}
+}
 
+func (session *clientSession) initVmware() {
 	// VMware Cloud Foundation as a Service
 	// Construct an instance of the 'VMware Cloud Foundation as a Service API' service.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	if session.vmwareClientErr == nil {
 		// Construct the service options.
 		vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
@@ -3584,6 +4294,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		if err == nil {
 			// Enable retries for API calls
 			session.vmwareClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.wrapServiceTransport(session.vmwareClient.Service)
 			// Add custom header for analytics
 			session.vmwareClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3592,15 +4303,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.vmwareClientErr = fmt.Errorf("Error occurred while constructing 'VMware Cloud Foundation as a Service API' service client: %q", err)
 		}
 	}
+}
 
+func (session *clientSession) initCodeEngine() {
 	// Construct the service options.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
-	}
+	codeEngineEndpoint = session.endpoint("IBMCLOUD_CODE_ENGINE_API_ENDPOINT", codeEngineEndpoint)
 	codeEngineClientOptions := &codeengine.CodeEngineV2Options{
 		Authenticator: authenticator,
 		URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
@@ -3612,6 +4326,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err == nil {
 		// Enable retries for API calls
 		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.codeEngineClient.Service)
 		// Add custom header for analytics
 		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3619,8 +4334,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
 	}
+}
 
+func (session *clientSession) initSdsaas() {
 	// Construct an instance of the 'sdsaas' service.
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	if session.sdsaasClientErr == nil {
 		// Construct the service options.
 		sdsaasClientOptions := &sdsaasv1.SdsaasV1Options{
@@ -3632,6 +4352,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		if err == nil {
 			// Enable retries for API calls
 			session.sdsaasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.wrapServiceTransport(session.sdsaasClient.Service)
 			// Add custom header for analytics
 			session.sdsaasClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3640,20 +4361,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.sdsaasClientErr = fmt.Errorf("Error occurred while constructing 'sdsaas' service client: %q", err)
 		}
 	}
+}
 
+func (session *clientSession) initGlobalCatalog() {
 	// CATALOG MANAGEMENT Service
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	globalcatalogURL := globalcatalogv1.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.globalcatalog/api/v1", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpoint supports only us-south and us-east region specific endpoint")
-			rmURL = ContructEndpoint("private.us-south.globalcatalog/api/v1", fmt.Sprintf("%s", cloudEndpoint))
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
 	globalCatalogClientOptions := &globalcatalogv1.GlobalCatalogV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT"}, globalcatalogURL),
 		Authenticator: authenticator,
@@ -3666,21 +4381,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if session.globalCatalogClient != nil && session.globalCatalogClient.Service != nil {
 		// Enable retries for API calls
 		session.globalCatalogClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.wrapServiceTransport(session.globalCatalogClient.Service)
 		// Add custom header for analytics
 		session.globalCatalogClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
-	}
-
-	// setting UserAgent for vpc-go-sdk common
-	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
-	return session, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -3691,6 +4397,10 @@ func CreateVersionDate() *string {
 
 func newSession(c *Config) (*Session, error) {
 	ibmSession := &Session{}
+	endpointsFile, err := c.endpointsFile()
+	if err != nil {
+		return nil, err
+	}
 
 	softlayerSession := &slsession.Session{
 		Endpoint:  c.SoftLayerEndpointURL,
@@ -3713,12 +4423,13 @@ func newSession(c *Config) (*Session, error) {
 		softlayerSession.UserName = c.SoftLayerUserName
 	}
 	softlayerSession.AppendUserAgent(fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
+	softlayerSession.HTTPClient = c.wrapHTTPClient(softlayerSession.HTTPClient)
 	ibmSession.SoftLayerSession = softlayerSession
 
-	if c.IAMTrustedProfileID == "" && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
+	if c.IAMTrustedProfileID == "" && c.authenticator == nil && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
 		return nil, fmt.Errorf("iam_token and iam_refresh_token must be provided")
 	}
-	if c.IAMTrustedProfileID != "" && c.IAMToken == "" {
+	if c.IAMTrustedProfileID != "" && c.IAMToken == "" && c.AuthType == "" {
 		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
 	}
 
@@ -3738,11 +4449,15 @@ func newSession(c *Config) (*Session, error) {
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			// The locator resolves the endpoints with the layouts of the
+			// endpoints file not supported by bluemix-go.
+			EndpointLocator: c.endpointLocator(endpointsFile),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
 		}
+		sess.Config.HTTPClient = c.wrapHTTPClient(http.NewHTTPClient(sess.Config))
 		ibmSession.BluemixSession = sess
 	}
 
@@ -3761,11 +4476,15 @@ func newSession(c *Config) (*Session, error) {
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			// The locator resolves the endpoints with the layouts of the
+			// endpoints file not supported by bluemix-go.
+			EndpointLocator: c.endpointLocator(endpointsFile),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
 			return nil, err
 		}
+		sess.Config.HTTPClient = c.wrapHTTPClient(http.NewHTTPClient(sess.Config))
 		ibmSession.BluemixSession = sess
 	}
 
@@ -3854,34 +4573,21 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	return defaultValue
 }
 
+// FileFallBack returns the URL of the service with the endpoint key in the
+// endpoints file for the visibility and region, or defaultValue. The endpoints
+// file has already been validated when the provider was configured.
 func FileFallBack(endpointsFile, visibility, key, region, defaultValue string) string {
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, endpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
-		}
-		defer jsonFile.Close()
-		bytes, err := io.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
-		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
-		}
+	path := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, endpointsFile)
+	if path == "" {
+		return defaultValue
 	}
-
-	return fileFallBack(fileMap, visibility, key, region, defaultValue)
-}
-
-func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
+	f, err := LoadEndpointsFile(path)
+	if err != nil {
+		log.Println(err)
+		return defaultValue
+	}
+	if url, ok := f.Endpoint(key, visibility, region); ok {
+		return url
 	}
 	return defaultValue
 }
@@ -3901,10 +4607,11 @@ func DefaultTransport() gohttp.RoundTripper {
 
 func isRetryable(err error) bool {
 	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
-			return true
-		}
+		return isRetryableStatusCode(bmErr.StatusCode())
+	}
+
+	if authErr, ok := err.(*core.AuthenticationError); ok && authErr.Response != nil {
+		return isRetryableStatusCode(authErr.Response.StatusCode)
 	}
 
 	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
@@ -3922,6 +4629,14 @@ func isRetryable(err error) bool {
 	return false
 }
 
+func isRetryableStatusCode(statusCode int) bool {
+	switch statusCode {
+	case 408, 504, 599, 429, 500, 502, 520, 503:
+		return true
+	}
+	return false
+}
+
 func ContructEndpoint(subdomain, domain string) string {
 	endpoint := fmt.Sprintf("https://%s.%s", subdomain, domain)
 	return endpoint
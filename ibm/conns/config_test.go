@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"sync"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func testClientSession() *clientSession {
+	return &clientSession{
+		session: &Session{
+			BluemixSession: &bxsession.Session{Config: &bluemix.Config{Region: "us-south"}},
+		},
+		config:        &Config{Region: "us-south", RetryCount: 1},
+		authenticator: &core.NoAuthAuthenticator{},
+	}
+}
+
+func TestClientSessionLazyClient(t *testing.T) {
+	session := testClientSession()
+	if session.vpcAPI != nil {
+		t.Fatal("VPC client was built before VpcV1API was called")
+	}
+
+	var wg sync.WaitGroup
+	clients := make(chan interface{}, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client, err := session.VpcV1API()
+			if err != nil {
+				t.Errorf("VpcV1API returned an error: %s", err)
+			}
+			clients <- client
+		}()
+	}
+	wg.Wait()
+	close(clients)
+
+	first := <-clients
+	for client := range clients {
+		if client != first {
+			t.Fatal("VpcV1API built more than one VPC client")
+		}
+	}
+	if session.vpcBetaAPI != nil {
+		t.Fatal("VPC beta client was built without VpcV1BetaAPI being called")
+	}
+}
+
+func TestClientSessionLazyClientEnvEndpoint(t *testing.T) {
+	t.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://vpc.example.com/v1")
+	session := testClientSession()
+
+	client, err := session.VpcV1API()
+	if err != nil {
+		t.Fatalf("VpcV1API returned an error: %s", err)
+	}
+	if url := client.GetServiceURL(); url != "https://vpc.example.com/v1" {
+		t.Fatalf("VPC client uses %s, expected the endpoint from the environment", url)
+	}
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	session := &clientSession{
+		session:   &Session{},
+		vpcErr:    errEmptyBluemixCredentials,
+		cisDNSErr: errEmptyBluemixCredentials,
+	}
+
+	if _, err := session.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("VpcV1API returned %v, expected errEmptyBluemixCredentials", err)
+	}
+	if _, err := session.CisDNSRecordClientSession(); err != errEmptyBluemixCredentials {
+		t.Fatalf("CisDNSRecordClientSession returned %v, expected errEmptyBluemixCredentials", err)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+// DefaultTags returns the tags of tagType, user or access, which the provider
+// attaches to every taggable resource.
+func (sess *clientSession) DefaultTags(tagType string) []string {
+	if tagType == "access" {
+		return sess.config.DefaultAccessTags
+	}
+	if tagType == "" || tagType == "user" {
+		return sess.config.DefaultTags
+	}
+	return nil
+}
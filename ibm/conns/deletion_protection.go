@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"path"
+)
+
+// DeletionProtectionRule prevents the deletion of the resources of the given
+// types, or with one of the given tags. When both are given, a resource must
+// match both to be protected. Types and tags may be glob patterns, like
+// ibm_is_* or env:prod*.
+type DeletionProtectionRule struct {
+	Name          string
+	ResourceTypes []string
+	Tags          []string
+}
+
+// Protects reports whether r prevents the deletion of a resource of
+// resourceType with tags.
+func (r DeletionProtectionRule) Protects(resourceType string, tags []string) bool {
+	if len(r.ResourceTypes) == 0 && len(r.Tags) == 0 {
+		return false
+	}
+	if len(r.ResourceTypes) > 0 && !matchesAny(r.ResourceTypes, resourceType) {
+		return false
+	}
+	if len(r.Tags) > 0 {
+		for _, tag := range tags {
+			if matchesAny(r.Tags, tag) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, value); (err == nil && matched) || pattern == value {
+			return true
+		}
+	}
+	return false
+}
+
+// DeletionProtection returns the deletion protection rules of the provider.
+func (sess *clientSession) DeletionProtection() []DeletionProtectionRule {
+	return sess.config.DeletionProtection
+}
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestDeletionProtectionRule(t *testing.T) {
+	for _, tc := range []struct {
+		rule     DeletionProtectionRule
+		resource string
+		tags     []string
+		protects bool
+	}{
+		{DeletionProtectionRule{ResourceTypes: []string{"ibm_database"}}, "ibm_database", nil, true},
+		{DeletionProtectionRule{ResourceTypes: []string{"ibm_database"}}, "ibm_is_vpc", nil, false},
+		{DeletionProtectionRule{ResourceTypes: []string{"ibm_is_*"}}, "ibm_is_volume", nil, true},
+		{DeletionProtectionRule{Tags: []string{"env:prod"}}, "ibm_is_vpc", []string{"team:a", "env:prod"}, true},
+		{DeletionProtectionRule{Tags: []string{"env:prod"}}, "ibm_is_vpc", []string{"env:dev"}, false},
+		{DeletionProtectionRule{Tags: []string{"env:prod*"}}, "ibm_is_vpc", []string{"env:production"}, true},
+		{DeletionProtectionRule{ResourceTypes: []string{"ibm_cos_bucket"}, Tags: []string{"env:prod"}}, "ibm_cos_bucket", []string{"env:dev"}, false},
+		{DeletionProtectionRule{ResourceTypes: []string{"ibm_cos_bucket"}, Tags: []string{"env:prod"}}, "ibm_cos_bucket", []string{"env:prod"}, true},
+		{DeletionProtectionRule{ResourceTypes: []string{"ibm_[kms"}}, "ibm_[kms", nil, true},
+		{DeletionProtectionRule{}, "ibm_is_vpc", nil, false},
+	} {
+		if protects := tc.rule.Protects(tc.resource, tc.tags); protects != tc.protects {
+			t.Errorf("%+v.Protects(%s, %v) returned %t", tc.rule, tc.resource, tc.tags, protects)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//
+// Drift report.
+//
+// When a drift report file is configured, every refresh of a resource compares
+// the arguments read from the service with the prior state, and appends the
+// arguments which differ to the file as a JSON line, with the time and the
+// author of the last change of the resource when the service tells them.
+// Computed attributes, which change without a change of the configuration,
+// are not reported, and the values of sensitive arguments never are.
+//
+
+var (
+	driftMu     sync.Mutex
+	driftReport *driftReporter
+)
+
+// driftUpdatedAtKeys and driftModifiedByKeys are the attributes telling when
+// and by whom a resource was last changed, by order of preference.
+var (
+	driftUpdatedAtKeys  = []string{"updated_at", "modified_at", "updated_on", "modified_on", "last_modified", "last_modified_at", "updated"}
+	driftModifiedByKeys = []string{"updated_by", "modified_by", "last_modified_by"}
+)
+
+// driftSensitiveValue replaces the values of sensitive arguments.
+const driftSensitiveValue = "(sensitive value)"
+
+// DriftEntry is a line of the drift report, the drifted arguments of a
+// resource.
+type DriftEntry struct {
+	Type       string           `json:"type"`
+	Time       string           `json:"time"`
+	Resource   string           `json:"resource"`
+	ID         string           `json:"id"`
+	Removed    bool             `json:"removed,omitempty"`
+	UpdatedAt  string           `json:"updated_at,omitempty"`
+	ModifiedBy string           `json:"modified_by,omitempty"`
+	Attributes []DriftAttribute `json:"attributes,omitempty"`
+}
+
+// DriftAttribute is a drifted argument. The value of a block, list, set or
+// map is the map of its flattened attributes, as in the state.
+type DriftAttribute struct {
+	Attribute string      `json:"attribute"`
+	Old       interface{} `json:"old"`
+	New       interface{} `json:"new"`
+}
+
+// driftReporter writes the drift report of the provider to a file.
+type driftReporter struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+	enc  *json.Encoder
+}
+
+// startDriftReport starts appending the drift report of the provider to path.
+// All the configurations of the provider in a process share the first file.
+func startDriftReport(path string) error {
+	driftMu.Lock()
+	defer driftMu.Unlock()
+	if driftReport != nil {
+		if driftReport.path != path {
+			log.Printf("[WARN] The drift is already reported to %s, ignoring %s", driftReport.path, path)
+		}
+		return nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening the drift report file %s: %s", path, err)
+	}
+	driftReport = &driftReporter{path: path, file: file, enc: json.NewEncoder(file)}
+	return nil
+}
+
+func currentDriftReporter() *driftReporter {
+	driftMu.Lock()
+	defer driftMu.Unlock()
+	return driftReport
+}
+
+// CloseDriftReport closes the drift report file.
+func CloseDriftReport() {
+	driftMu.Lock()
+	r := driftReport
+	driftReport = nil
+	driftMu.Unlock()
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.file.Close()
+}
+
+// StartDriftCheck records the prior state of the resource d before it is
+// read, and returns the function reporting the drift of its arguments once
+// read. It does nothing when there is no drift report, or when there is no
+// prior state to compare with, as when a resource is imported.
+func StartDriftCheck(resource string, s map[string]*schema.Schema, d *schema.ResourceData) func(d *schema.ResourceData) {
+	r := currentDriftReporter()
+	prior := d.State()
+	if r == nil || prior == nil || prior.ID == "" || len(prior.Attributes) <= 1 {
+		return func(*schema.ResourceData) {}
+	}
+	before := prior.Attributes
+	id := prior.ID
+	return func(d *schema.ResourceData) {
+		entry := DriftEntry{
+			Type:     "drift",
+			Time:     time.Now().UTC().Format(time.RFC3339Nano),
+			Resource: resource,
+			ID:       id,
+		}
+		if state := d.State(); d.Id() == "" || state == nil {
+			entry.Removed = true
+		} else {
+			entry.Attributes = driftAttributes(s, before, state.Attributes)
+			if len(entry.Attributes) == 0 {
+				return
+			}
+			entry.UpdatedAt = driftFirstValue(state.Attributes, driftUpdatedAtKeys)
+			entry.ModifiedBy = driftFirstValue(state.Attributes, driftModifiedByKeys)
+		}
+		r.write(entry)
+	}
+}
+
+func (r *driftReporter) write(entry DriftEntry) {
+	if entry.Removed {
+		log.Printf("[WARN] Drift of %s %s: the resource was removed", entry.Resource, entry.ID)
+	} else {
+		names := make([]string, len(entry.Attributes))
+		for i, a := range entry.Attributes {
+			names[i] = a.Attribute
+		}
+		log.Printf("[WARN] Drift of %s %s: %s", entry.Resource, entry.ID, strings.Join(names, ", "))
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.enc.Encode(entry); err != nil {
+		log.Printf("[WARN] Error writing the drift report: %s", err)
+	}
+}
+
+// driftAttributes returns the arguments of the schema s whose flattened
+// attributes differ between before and after, by name.
+func driftAttributes(s map[string]*schema.Schema, before, after map[string]string) []DriftAttribute {
+	changed := map[string]bool{}
+	for _, attributes := range []map[string]string{before, after} {
+		for k := range attributes {
+			name := strings.SplitN(k, ".", 2)[0]
+			if changed[name] || !isDriftArgument(s[name]) {
+				continue
+			}
+			oldValue, oldOK := before[k]
+			newValue, newOK := after[k]
+			if !sameDriftValue(oldValue, oldOK, newValue, newOK) {
+				changed[name] = true
+			}
+		}
+	}
+
+	var drift []DriftAttribute
+	for name := range changed {
+		a := DriftAttribute{Attribute: name, Old: driftValue(s[name], name, before), New: driftValue(s[name], name, after)}
+		if s[name].Sensitive {
+			a.Old, a.New = driftSensitiveValue, driftSensitiveValue
+		}
+		drift = append(drift, a)
+	}
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Attribute < drift[j].Attribute
+	})
+	return drift
+}
+
+// isDriftArgument reports whether a change of the attribute is a drift from
+// the configuration, which is not the case of the computed attributes.
+func isDriftArgument(s *schema.Schema) bool {
+	return s != nil && (s.Required || s.Optional)
+}
+
+// sameDriftValue reports whether two flattened values are the same. A missing
+// value is the same as a zero value.
+func sameDriftValue(oldValue string, oldOK bool, newValue string, newOK bool) bool {
+	if oldOK && newOK {
+		return oldValue == newValue
+	}
+	if oldOK {
+		newValue = oldValue
+	}
+	return newValue == "" || newValue == "0" || newValue == "false"
+}
+
+// driftValue returns the value of the attribute name in the flattened
+// attributes: a string, or the map of its flattened attributes.
+func driftValue(s *schema.Schema, name string, attributes map[string]string) interface{} {
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet, schema.TypeMap:
+		m := map[string]string{}
+		for k, v := range attributes {
+			if strings.HasPrefix(k, name+".") {
+				m[k] = v
+			}
+		}
+		return m
+	}
+	return attributes[name]
+}
+
+func driftFirstValue(attributes map[string]string, keys []string) string {
+	for _, k := range keys {
+		if v := attributes[k]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
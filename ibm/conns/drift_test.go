@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var testDriftSchema = map[string]*schema.Schema{
+	"name":        {Type: schema.TypeString, Required: true},
+	"description": {Type: schema.TypeString, Optional: true},
+	"password":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+	"enabled":     {Type: schema.TypeBool, Optional: true},
+	"tags":        {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	"crn":         {Type: schema.TypeString, Computed: true},
+	"updated_at":  {Type: schema.TypeString, Computed: true},
+	"updated_by":  {Type: schema.TypeString, Computed: true},
+}
+
+func testDriftResourceData(t *testing.T, attributes map[string]string) *schema.ResourceData {
+	state := &terraform.InstanceState{ID: "r006-test", Attributes: map[string]string{"id": "r006-test"}}
+	for k, v := range attributes {
+		state.Attributes[k] = v
+	}
+	d, err := schema.InternalMap(testDriftSchema).Data(state, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestDriftReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drift.jsonl")
+	if err := startDriftReport(path); err != nil {
+		t.Fatal(err)
+	}
+	defer CloseDriftReport()
+
+	// A resource which drifted.
+	d := testDriftResourceData(t, map[string]string{
+		"name":        "test",
+		"description": "before",
+		"password":    "secret",
+		"tags.%":      "1",
+		"tags.env":    "dev",
+		"crn":         "crn:v1:old",
+	})
+	endDriftCheck := StartDriftCheck("ibm_test", testDriftSchema, d)
+	d.Set("description", "after")
+	d.Set("password", "changed")
+	d.Set("enabled", false)
+	d.Set("tags", map[string]interface{}{"env": "prod"})
+	d.Set("crn", "crn:v1:new")
+	d.Set("updated_at", "2024-05-01T10:00:00Z")
+	d.Set("updated_by", "IBMid-123")
+	endDriftCheck(d)
+
+	// A resource which did not drift.
+	d = testDriftResourceData(t, map[string]string{"name": "test"})
+	endDriftCheck = StartDriftCheck("ibm_test", testDriftSchema, d)
+	d.Set("crn", "crn:v1:new")
+	endDriftCheck(d)
+
+	// An imported resource, without prior state.
+	d = testDriftResourceData(t, nil)
+	endDriftCheck = StartDriftCheck("ibm_test", testDriftSchema, d)
+	d.Set("name", "imported")
+	endDriftCheck(d)
+
+	// A resource which was deleted.
+	d = testDriftResourceData(t, map[string]string{"name": "test"})
+	endDriftCheck = StartDriftCheck("ibm_test", testDriftSchema, d)
+	d.SetId("")
+	endDriftCheck(d)
+	CloseDriftReport()
+
+	lines := readTrace(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("Drift report has %d lines, expected 2: %v", len(lines), lines)
+	}
+	drift, removed := lines[0], lines[1]
+	for k, v := range map[string]interface{}{
+		"type":        "drift",
+		"resource":    "ibm_test",
+		"id":          "r006-test",
+		"updated_at":  "2024-05-01T10:00:00Z",
+		"modified_by": "IBMid-123",
+	} {
+		if drift[k] != v {
+			t.Errorf("Drift %s is %v, expected %v", k, drift[k], v)
+		}
+	}
+	expected := []interface{}{
+		map[string]interface{}{"attribute": "description", "old": "before", "new": "after"},
+		map[string]interface{}{"attribute": "password", "old": driftSensitiveValue, "new": driftSensitiveValue},
+		map[string]interface{}{
+			"attribute": "tags",
+			"old":       map[string]interface{}{"tags.%": "1", "tags.env": "dev"},
+			"new":       map[string]interface{}{"tags.%": "1", "tags.env": "prod"},
+		},
+	}
+	if !reflect.DeepEqual(drift["attributes"], expected) {
+		t.Errorf("Drift attributes are %v, expected %v", drift["attributes"], expected)
+	}
+	if removed["removed"] != true || removed["id"] != "r006-test" || removed["attributes"] != nil {
+		t.Errorf("Drift of the deleted resource is %v", removed)
+	}
+}
+
+func TestDriftCheckWithoutReport(t *testing.T) {
+	d := testDriftResourceData(t, map[string]string{"name": "test"})
+	endDriftCheck := StartDriftCheck("ibm_test", testDriftSchema, d)
+	d.Set("name", "changed")
+	endDriftCheck(d)
+}
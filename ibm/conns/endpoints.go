@@ -0,0 +1,470 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+)
+
+// Sources of the endpoint of a service reported by ServiceEndpoints.
+const (
+	EndpointSourceEnv     = "env"
+	EndpointSourceFile    = "endpoints_file"
+	EndpointSourceDefault = "default"
+)
+
+// endpointsDefault is the visibility or region of an endpoints file applying
+// when the file has no entry for the visibility or region of the provider.
+const endpointsDefault = "default"
+
+// EndpointsFile is an endpoints file, which maps the endpoint keys of the
+// services to their URL by visibility and region:
+//
+//	{
+//	  "IBMCLOUD_IS_NG_API_ENDPOINT": {
+//	    "private": {"us-south": "https://...", "default": "https://..."},
+//	    "default": "https://..."
+//	  },
+//	  "IBMCLOUD_GT_API_ENDPOINT": "https://..."
+//	}
+//
+// A URL given for a service or for a visibility applies to all its
+// visibilities or regions.
+type EndpointsFile struct {
+	Path string
+	// endpoints maps the keys to the URLs by visibility and region, a URL
+	// given for a whole service or visibility is in their default entries.
+	endpoints map[string]map[string]map[string]string
+}
+
+var (
+	endpointsFilesMu sync.Mutex
+	endpointsFiles   = map[string]*EndpointsFile{}
+)
+
+// LoadEndpointsFile returns the endpoints file at path, which is parsed and
+// validated once.
+func LoadEndpointsFile(path string) (*EndpointsFile, error) {
+	endpointsFilesMu.Lock()
+	defer endpointsFilesMu.Unlock()
+	if f, ok := endpointsFiles[path]; ok {
+		return f, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to read the endpoints file: %s", err)
+	}
+	f, err := parseEndpointsFile(path, data)
+	if err != nil {
+		return nil, err
+	}
+	endpointsFiles[path] = f
+	return f, nil
+}
+
+func parseEndpointsFile(path string, data []byte) (*EndpointsFile, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to parse the endpoints file %s: %s", path, err)
+	}
+
+	f := &EndpointsFile{Path: path, endpoints: map[string]map[string]map[string]string{}}
+	var unknown []string
+	for key, value := range raw {
+		if !isEndpointKey(key) {
+			unknown = append(unknown, key)
+		}
+		visibilities := map[string]map[string]string{}
+		switch value := value.(type) {
+		case string:
+			if value == "" {
+				return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: the endpoint of %s is empty", path, key)
+			}
+			visibilities[endpointsDefault] = map[string]string{endpointsDefault: value}
+		case map[string]interface{}:
+			for visibility, regions := range value {
+				if !isEndpointsVisibility(visibility) {
+					return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: unknown visibility %s of %s, expected one of public, private, public-and-private or default", path, visibility, key)
+				}
+				urls, err := parseEndpointsRegions(regions)
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %s of %s %s", path, err, key, visibility)
+				}
+				visibilities[visibility] = urls
+			}
+		default:
+			return nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %s must be a URL or an object of visibilities", path, key)
+		}
+		f.endpoints[key] = visibilities
+	}
+	if len(unknown) > 0 {
+		// The keys may still be read by services which do not report their
+		// endpoint, so they are kept.
+		sort.Strings(unknown)
+		log.Printf("[WARN] Unknown endpoint keys in the endpoints file %s: %s", path, strings.Join(unknown, ", "))
+	}
+	return f, nil
+}
+
+func parseEndpointsRegions(regions interface{}) (map[string]string, error) {
+	switch regions := regions.(type) {
+	case string:
+		if regions == "" {
+			return nil, fmt.Errorf("empty endpoint")
+		}
+		return map[string]string{endpointsDefault: regions}, nil
+	case map[string]interface{}:
+		urls := map[string]string{}
+		for region, url := range regions {
+			s, ok := url.(string)
+			if !ok || s == "" {
+				return nil, fmt.Errorf("the endpoint of region %s must be a non-empty string", region)
+			}
+			urls[region] = s
+		}
+		return urls, nil
+	}
+	return nil, fmt.Errorf("the endpoints must be a URL or an object of regions")
+}
+
+func isEndpointsVisibility(visibility string) bool {
+	switch visibility {
+	case "public", "private", "public-and-private", endpointsDefault:
+		return true
+	}
+	return false
+}
+
+// Endpoint returns the URL of the service with the endpoint key for the
+// visibility and region, falling back to the default visibility and region of
+// the file, and then to the legacy key of the service.
+func (f *EndpointsFile) Endpoint(key, visibility, region string) (string, bool) {
+	if f == nil {
+		return "", false
+	}
+	for _, k := range []string{key, legacyEndpointKeys[key]} {
+		for _, v := range []string{visibility, endpointsDefault} {
+			regions, ok := f.endpoints[k][v]
+			if !ok {
+				continue
+			}
+			for _, r := range []string{region, endpointsDefault} {
+				if url, ok := regions[r]; ok {
+					return url, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// endpointsFile returns the endpoints file of c, which may also be given by
+// the IBMCLOUD_ENDPOINTS_FILE_PATH environment variable, or nil.
+func (c *Config) endpointsFile() (*EndpointsFile, error) {
+	path := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile)
+	if path == "" {
+		return nil, nil
+	}
+	return LoadEndpointsFile(path)
+}
+
+// endpoint returns the URL of the service with the endpoint key in the
+// endpoints file, or defaultValue, and records it for ServiceEndpoints. The
+// endpoints file is not used with the public-and-private visibility.
+func (session *clientSession) endpoint(key, defaultValue string) string {
+	c := session.config
+	url := defaultValue
+	if c.Visibility != "public-and-private" {
+		if u, ok := session.endpointsFile.Endpoint(key, c.Visibility, c.Region); ok {
+			url = u
+		}
+	}
+	session.endpointsMu.Lock()
+	defer session.endpointsMu.Unlock()
+	if session.endpoints == nil {
+		session.endpoints = map[string]string{}
+	}
+	if _, ok := session.endpoints[key]; !ok {
+		session.endpoints[key] = url
+	}
+	return url
+}
+
+// ServiceEndpoint is the endpoint used by the provider for a service, and
+// where it comes from: the environment, the endpoints file or the defaults of
+// the provider.
+type ServiceEndpoint struct {
+	Key    string
+	URL    string
+	Source string
+}
+
+// ServiceEndpoints returns the endpoints of the services with the given
+// endpoint keys, or of all the services with EndpointKeys when keys is empty.
+func (session *clientSession) ServiceEndpoints(keys []string) ([]ServiceEndpoint, error) {
+	if len(keys) == 0 {
+		keys = EndpointKeys()
+	}
+	c := session.config
+	var result []ServiceEndpoint
+	for _, key := range keys {
+		init, isService := serviceEndpointClients[key]
+		locate, isLocator := locatorEndpoints[key]
+		if !isService && !isLocator {
+			return nil, fmt.Errorf("[ERROR] The endpoint of %s is not known, expected one of %s", key, strings.Join(EndpointKeys(), ", "))
+		}
+
+		endpoint := ServiceEndpoint{Key: key, Source: EndpointSourceDefault}
+		if os.Getenv(key) != "" {
+			endpoint.Source = EndpointSourceEnv
+		} else if _, ok := session.endpointsFile.Endpoint(key, c.Visibility, c.Region); ok && c.Visibility != "public-and-private" {
+			endpoint.Source = EndpointSourceFile
+		}
+		if isService {
+			init(session)
+			session.endpointsMu.Lock()
+			endpoint.URL = EnvFallBack([]string{key}, session.endpoints[key])
+			session.endpointsMu.Unlock()
+		} else if session.session != nil && session.session.BluemixSession != nil {
+			// The URLs of the bluemix-go clients already account for the
+			// environment and the endpoints file.
+			endpoint.URL, _ = locate(session.session.BluemixSession.Config.EndpointLocator)
+		}
+		result = append(result, endpoint)
+	}
+	return result, nil
+}
+
+// serviceEndpointClients initializes the clients of the services with their
+// endpoint key, which records the resolved endpoints.
+var serviceEndpointClients = map[string]func(*clientSession){
+	"IBMCLOUD_API_GATEWAY_ENDPOINT":                func(s *clientSession) { s.APIGateway() },
+	"IBMCLOUD_APP_CONFIG_ENDPOINT":                 func(s *clientSession) { s.AppConfigurationV1() },
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT":       func(s *clientSession) { s.AppIDAPI() },
+	"IBMCLOUD_ATRACKER_API_ENDPOINT":               func(s *clientSession) { s.AtrackerV2() },
+	"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT":            func(s *clientSession) { s.BackupRecoveryV1() },
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT":     func(s *clientSession) { s.CatalogManagementV1() },
+	"IBMCLOUD_CIS_API_ENDPOINT":                    func(s *clientSession) { s.cisEndpoint() },
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT":            func(s *clientSession) { s.IBMCloudShellV1() },
+	"IBMCLOUD_CODE_ENGINE_API_ENDPOINT":            func(s *clientSession) { s.CodeEngineV2() },
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT": func(s *clientSession) { s.ContextBasedRestrictionsV1() },
+	"IBMCLOUD_COS_CONFIG_ENDPOINT":                 func(s *clientSession) { s.CosConfigV1API() },
+	"IBMCLOUD_CR_API_ENDPOINT":                     func(s *clientSession) { s.ContainerRegistryV1() },
+	"IBMCLOUD_DL_API_ENDPOINT":                     func(s *clientSession) { s.DirectlinkV1API() },
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT":            func(s *clientSession) { s.DirectlinkProviderV2API() },
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT":             func(s *clientSession) { s.EnterpriseManagementV1() },
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT":    func(s *clientSession) { s.EventNotificationsApiV1() },
+	"IBMCLOUD_GS_API_ENDPOINT":                     func(s *clientSession) { s.GlobalSearchAPIV2() },
+	"IBMCLOUD_GT_API_ENDPOINT":                     func(s *clientSession) { s.GlobalTaggingAPIv1() },
+	"IBMCLOUD_IAM_API_ENDPOINT":                    func(s *clientSession) {},
+	"IBMCLOUD_IS_NG_API_ENDPOINT":                  func(s *clientSession) { s.vpcEndpoint() },
+	"IBMCLOUD_KP_API_ENDPOINT":                     func(s *clientSession) { s.KeyProtectAPI() },
+	"IBMCLOUD_LOGS_API_ENDPOINT":                   func(s *clientSession) { s.LogsV0() },
+	"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT":           func(s *clientSession) { s.IBMCloudLogsRoutingV0() },
+	"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT":        func(s *clientSession) { s.MetricsRouterV3() },
+	"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT":             func(s *clientSession) { s.MqcloudV1() },
+	"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT":    func(s *clientSession) { s.PartnerCenterSellV1() },
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT":            func(s *clientSession) { s.PrivateDNSClientSession() },
+	"IBMCLOUD_PROJECT_API_ENDPOINT":                func(s *clientSession) { s.ProjectV1() },
+	"IBMCLOUD_PUSH_API_ENDPOINT":                   func(s *clientSession) { s.PushServiceV1() },
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT":    func(s *clientSession) { s.ResourceControllerV2API() },
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT":    func(s *clientSession) { s.ResourceManagerV2API() },
+	"IBMCLOUD_SATELLITE_API_ENDPOINT":              func(s *clientSession) { s.SatelliteClientSession() },
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT":         func(s *clientSession) { s.SatellitLinkClientSession() },
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT":             func(s *clientSession) { s.SchematicsV1() },
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT":            func(s *clientSession) { s.CdTektonPipelineV2() },
+	"IBMCLOUD_TG_API_ENDPOINT":                     func(s *clientSession) { s.TransitGatewayV1API() },
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT":                  func(s *clientSession) { s.CdToolchainV2() },
+	"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT":          func(s *clientSession) { s.UsageReportsV4() },
+}
+
+// locatorEndpoints are the endpoints of the services with a bluemix-go
+// client, which reads the endpoints file through its endpoint locator.
+var locatorEndpoints = map[string]func(endpoints.EndpointLocator) (string, error){
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT":  endpoints.EndpointLocator.AccountManagementEndpoint,
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT": endpoints.EndpointLocator.CertificateManagerEndpoint,
+	"IBMCLOUD_CS_API_ENDPOINT":                  endpoints.EndpointLocator.ContainerEndpoint,
+	"IBMCLOUD_CSE_ENDPOINT":                     endpoints.EndpointLocator.CseEndpoint,
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT":           endpoints.EndpointLocator.FunctionsEndpoint,
+	"IBMCLOUD_HPCS_API_ENDPOINT":                endpoints.EndpointLocator.HpcsEndpoint,
+	"IBMCLOUD_IAMPAP_API_ENDPOINT":              endpoints.EndpointLocator.IAMPAPEndpoint,
+	"IBMCLOUD_ICD_API_ENDPOINT":                 endpoints.EndpointLocator.ICDEndpoint,
+	"IBMCLOUD_MCCP_API_ENDPOINT":                endpoints.EndpointLocator.MCCPAPIEndpoint,
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT":    endpoints.EndpointLocator.ResourceCatalogEndpoint,
+	"IBMCLOUD_SAT_API_ENDPOINT":                 endpoints.EndpointLocator.SatelliteEndpoint,
+	"IBMCLOUD_UAA_ENDPOINT":                     endpoints.EndpointLocator.UAAEndpoint,
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT":         endpoints.EndpointLocator.UserManagementEndpoint,
+}
+
+// fileEndpointKeys are the endpoint keys which are only read from the
+// endpoints file by individual resources, like the endpoints of the COS
+// buckets which depend on their location.
+var fileEndpointKeys = []string{"IBMCLOUD_COS_ENDPOINT"}
+
+// legacyEndpointKeys are the keys which endpoints files used to give the
+// endpoints of services, which still apply when the file has no entry for
+// their endpoint key.
+var legacyEndpointKeys = map[string]string{
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT": "IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT",
+}
+
+// EndpointKeys returns the sorted endpoint keys of the services reported by
+// ServiceEndpoints.
+func EndpointKeys() []string {
+	keys := make([]string, 0, len(serviceEndpointClients)+len(locatorEndpoints))
+	for key := range serviceEndpointClients {
+		keys = append(keys, key)
+	}
+	for key := range locatorEndpoints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isEndpointKey returns whether key is an endpoint key supported in the
+// endpoints file.
+func isEndpointKey(key string) bool {
+	if _, ok := serviceEndpointClients[key]; ok {
+		return true
+	}
+	if _, ok := locatorEndpoints[key]; ok {
+		return true
+	}
+	for _, k := range fileEndpointKeys {
+		if k == key {
+			return true
+		}
+	}
+	for _, k := range legacyEndpointKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointLocator is the endpoint locator of the bluemix-go sessions, which
+// resolves their endpoints with the endpoints file of the provider, as the
+// bluemix-go locator only supports the visibility and region layout of the
+// file. The environment still takes precedence.
+type endpointLocator struct {
+	endpoints.EndpointLocator
+	file       *EndpointsFile
+	visibility string
+	region     string
+}
+
+func (c *Config) endpointLocator(file *EndpointsFile) endpoints.EndpointLocator {
+	if file == nil {
+		return nil
+	}
+	return &endpointLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(c.Region, c.Visibility, file.Path),
+		file:            file,
+		visibility:      c.Visibility,
+		region:          c.Region,
+	}
+}
+
+func (l *endpointLocator) locate(key string, locate func() (string, error)) (string, error) {
+	if os.Getenv(key) == "" && l.visibility != "public-and-private" {
+		if url, ok := l.file.Endpoint(key, l.visibility, l.region); ok {
+			return url, nil
+		}
+	}
+	return locate()
+}
+
+func (l *endpointLocator) AccountManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (l *endpointLocator) CertificateManagerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", l.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (l *endpointLocator) ContainerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CS_API_ENDPOINT", l.EndpointLocator.ContainerEndpoint)
+}
+
+func (l *endpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CR_API_ENDPOINT", l.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (l *endpointLocator) CisEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CIS_API_ENDPOINT", l.EndpointLocator.CisEndpoint)
+}
+
+func (l *endpointLocator) GlobalSearchEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_GS_API_ENDPOINT", l.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (l *endpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_GT_API_ENDPOINT", l.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (l *endpointLocator) IAMEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_IAM_API_ENDPOINT", l.EndpointLocator.IAMEndpoint)
+}
+
+func (l *endpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_IAMPAP_API_ENDPOINT", l.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (l *endpointLocator) ICDEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_ICD_API_ENDPOINT", l.EndpointLocator.ICDEndpoint)
+}
+
+func (l *endpointLocator) MCCPAPIEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_MCCP_API_ENDPOINT", l.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (l *endpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (l *endpointLocator) ResourceControllerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", l.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (l *endpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", l.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (l *endpointLocator) UAAEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_UAA_ENDPOINT", l.EndpointLocator.UAAEndpoint)
+}
+
+func (l *endpointLocator) CseEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CSE_ENDPOINT", l.EndpointLocator.CseEndpoint)
+}
+
+func (l *endpointLocator) SchematicsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_SCHEMATICS_API_ENDPOINT", l.EndpointLocator.SchematicsEndpoint)
+}
+
+func (l *endpointLocator) UserManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_USER_MANAGEMENT_ENDPOINT", l.EndpointLocator.UserManagementEndpoint)
+}
+
+func (l *endpointLocator) HpcsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_HPCS_API_ENDPOINT", l.EndpointLocator.HpcsEndpoint)
+}
+
+func (l *endpointLocator) FunctionsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_FUNCTIONS_API_ENDPOINT", l.EndpointLocator.FunctionsEndpoint)
+}
+
+func (l *endpointLocator) SatelliteEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_SAT_API_ENDPOINT", l.EndpointLocator.SatelliteEndpoint)
+}
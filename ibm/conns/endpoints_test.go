@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeEndpointsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	return path
+}
+
+func TestEndpointsFile(t *testing.T) {
+	_, err := LoadEndpointsFile(writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {
+			"public": {"us-south": "https://vpc.us-south", "eu-de": ""},
+			"private": {"us-south": "https://vpc.private.us-south", "default": "https://vpc.private"},
+			"default": "https://vpc"
+		},
+		"IBMCLOUD_GT_API_ENDPOINT": "https://tags",
+		"IBMCLOUD_CS_API_ENDPOINT": {"private": "https://containers.private"}
+	}`))
+	if err == nil {
+		t.Fatal("LoadEndpointsFile accepted an empty endpoint")
+	}
+
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {
+			"public": {"us-south": "https://vpc.us-south"},
+			"private": {"us-south": "https://vpc.private.us-south", "default": "https://vpc.private"},
+			"default": "https://vpc"
+		},
+		"IBMCLOUD_GT_API_ENDPOINT": "https://tags",
+		"IBMCLOUD_CS_API_ENDPOINT": {"private": "https://containers.private"}
+	}`)
+	f, err := LoadEndpointsFile(path)
+	if err != nil {
+		t.Fatalf("LoadEndpointsFile returned an error: %s", err)
+	}
+	for _, tc := range []struct {
+		key, visibility, region string
+		expected                string
+	}{
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "public", "us-south", "https://vpc.us-south"},
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "public", "eu-de", "https://vpc"},
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "private", "us-south", "https://vpc.private.us-south"},
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "private", "eu-de", "https://vpc.private"},
+		{"IBMCLOUD_GT_API_ENDPOINT", "private", "eu-de", "https://tags"},
+		{"IBMCLOUD_CS_API_ENDPOINT", "private", "eu-de", "https://containers.private"},
+		{"IBMCLOUD_CS_API_ENDPOINT", "public", "eu-de", ""},
+		{"IBMCLOUD_KP_API_ENDPOINT", "public", "us-south", ""},
+	} {
+		url, ok := f.Endpoint(tc.key, tc.visibility, tc.region)
+		if url != tc.expected || ok != (tc.expected != "") {
+			t.Errorf("Endpoint(%s, %s, %s) returned %q, %v, expected %q", tc.key, tc.visibility, tc.region, url, ok, tc.expected)
+		}
+	}
+
+	// The file is only parsed once.
+	os.WriteFile(path, []byte(`{}`), 0600)
+	if cached, err := LoadEndpointsFile(path); err != nil || cached != f {
+		t.Errorf("LoadEndpointsFile parsed the file again: %v", err)
+	}
+}
+
+func TestEndpointsFileInvalid(t *testing.T) {
+	for content, message := range map[string]string{
+		`{"IBMCLOUD_IS_NG_API_ENDPOINT": {"direct": {"us-south": "https://vpc"}}}`: "unknown visibility direct",
+		`{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": 42}}}`:            "region us-south",
+		`{"IBMCLOUD_IS_NG_API_ENDPOINT": ["https://vpc"]}`:                         "must be a URL",
+		`{"IBMCLOUD_IS_NG_API_ENDPOINT": `:                                         "Unable to parse",
+	} {
+		_, err := LoadEndpointsFile(writeEndpointsFile(t, content))
+		if err == nil || !strings.Contains(err.Error(), message) {
+			t.Errorf("LoadEndpointsFile(%s) returned %v, expected %q", content, err, message)
+		}
+	}
+
+	c := &Config{Region: "us-south", IAMToken: "test-token", EndpointsFile: writeEndpointsFile(t, `{"IBMCLOUD_IS_NG_API_ENDPOINT": ""}`)}
+	if _, err := c.ClientSession(); err == nil || !strings.Contains(err.Error(), "IBMCLOUD_IS_NG_API_ENDPOINT") {
+		t.Errorf("ClientSession returned %v with an invalid endpoints file", err)
+	}
+}
+
+func TestEndpointsFileUnknownKeys(t *testing.T) {
+	f, err := LoadEndpointsFile(writeEndpointsFile(t, `{
+		"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT": "https://sm.example.com",
+		"IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT": {"public": {"default": "https://cm.example.com"}}
+	}`))
+	if err != nil {
+		t.Fatalf("LoadEndpointsFile returned an error with unknown keys: %s", err)
+	}
+	if url, ok := f.Endpoint("IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", "public", "us-south"); !ok || url != "https://sm.example.com" {
+		t.Errorf("Endpoint of an unknown key returned %q, %v", url, ok)
+	}
+	// The legacy key still gives the catalog management endpoint
+	if url, ok := f.Endpoint("IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", "public", "us-south"); !ok || url != "https://cm.example.com" {
+		t.Errorf("Endpoint of catalog management returned %q, %v", url, ok)
+	}
+}
+
+func TestServiceEndpoints(t *testing.T) {
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"default": "https://vpc.example.com/v1"}},
+		"IBMCLOUD_CS_API_ENDPOINT": "https://containers.example.com/global"
+	}`)
+	t.Setenv("IBMCLOUD_GT_API_ENDPOINT", "https://tags.example.com")
+	t.Setenv("IBMCLOUD_IAM_API_ENDPOINT", "")
+	c := &Config{Region: "us-south", Visibility: "public", IAMToken: testIAMToken("iam-Profile-test"), IAMTrustedProfileID: "Profile-test", EndpointsFile: path}
+	session, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("ClientSession returned an error: %s", err)
+	}
+
+	endpoints, err := session.(ClientSession).ServiceEndpoints([]string{
+		"IBMCLOUD_IS_NG_API_ENDPOINT",
+		"IBMCLOUD_GT_API_ENDPOINT",
+		"IBMCLOUD_CS_API_ENDPOINT",
+		"IBMCLOUD_IAM_API_ENDPOINT",
+	})
+	if err != nil {
+		t.Fatalf("ServiceEndpoints returned an error: %s", err)
+	}
+	expected := []ServiceEndpoint{
+		{"IBMCLOUD_IS_NG_API_ENDPOINT", "https://vpc.example.com/v1", EndpointSourceFile},
+		{"IBMCLOUD_GT_API_ENDPOINT", "https://tags.example.com", EndpointSourceEnv},
+		{"IBMCLOUD_CS_API_ENDPOINT", "https://containers.example.com/global", EndpointSourceFile},
+		{"IBMCLOUD_IAM_API_ENDPOINT", "https://iam.cloud.ibm.com", EndpointSourceDefault},
+	}
+	for n := range expected {
+		if endpoints[n] != expected[n] {
+			t.Errorf("ServiceEndpoints returned %+v, expected %+v", endpoints[n], expected[n])
+		}
+	}
+
+	if _, err := session.(ClientSession).ServiceEndpoints([]string{"IBMCLOUD_FOO"}); err == nil {
+		t.Error("ServiceEndpoints accepted an unknown service")
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
+)
+
+// PollIntervalRule sets the interval of the waiters of the resources of the
+// given types, which may be glob patterns like ibm_is_*.
+type PollIntervalRule struct {
+	ResourceTypes []string
+	Interval      waiter.Interval
+}
+
+// PollInterval returns the interval of the waiters of resourceType: the one
+// of the first matching rule of the provider, or the default one of the
+// resource type.
+func (sess *clientSession) PollInterval(resourceType string) waiter.Interval {
+	for _, rule := range sess.config.PollIntervals {
+		for _, pattern := range rule.ResourceTypes {
+			if waiter.Matches(pattern, resourceType) {
+				return rule.Interval
+			}
+		}
+	}
+	return waiter.DefaultIntervalFor(resourceType)
+}
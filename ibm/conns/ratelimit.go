@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// RateLimitDefault is the service family of the rate limit applied to
+	// the services without a rate limit of their own.
+	RateLimitDefault = "default"
+
+	// RetryAPIMaxDelay is the maximum backoff delay between two retries.
+	RetryAPIMaxDelay = 60 * time.Second
+	// rateLimitBaseDelay is the backoff delay of the first retry of a
+	// request rejected with 429 Too Many Requests.
+	rateLimitBaseDelay = 1 * time.Second
+)
+
+// RateLimitServiceFamilies are the service families which can be rate limited.
+var RateLimitServiceFamilies = []string{
+	RateLimitDefault, "iam", "vpc", "power", "container", "cis", "resource_controller",
+	"kms", "cos", "global_tagging", "secrets_manager", "classic",
+}
+
+// serviceFamilyLabels maps the labels of the hosts of the IBM Cloud APIs to
+// their service family.
+var serviceFamilyLabels = map[string]string{
+	"iam":                   "iam",
+	"iaas":                  "vpc",
+	"power-iaas":            "power",
+	"containers":            "container",
+	"cis":                   "cis",
+	"resource-controller":   "resource_controller",
+	"kms":                   "kms",
+	"hs-crypto":             "kms",
+	"cloud-object-storage":  "cos",
+	"global-search-tagging": "global_tagging",
+	"secrets-manager":       "secrets_manager",
+	"softlayer":             "classic",
+}
+
+// RateLimit is the client-side rate limit of the requests to a service family.
+type RateLimit struct {
+	RequestsPerSecond float64
+	// Burst is the number of requests which can be sent at once, it defaults
+	// to RequestsPerSecond rounded up.
+	Burst int
+}
+
+// rateLimiter throttles the requests of all the service clients of a
+// provider. The requests rejected with 429 Too Many Requests are retried by
+// the retry layer of the clients, or by the rateLimitTransport of the clients
+// without one.
+type rateLimiter struct {
+	limiters   map[string]*rate.Limiter
+	maxRetries int
+}
+
+func newRateLimiter(c *Config) *rateLimiter {
+	limiter := &rateLimiter{
+		limiters:   map[string]*rate.Limiter{},
+		maxRetries: c.RetryCount,
+	}
+	for family, limit := range c.RateLimits {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limit.RequestsPerSecond))
+		}
+		limiter.limiters[family] = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+	return limiter
+}
+
+// limiter returns the limiter of the service family of host, if any.
+func (r *rateLimiter) limiter(host string) *rate.Limiter {
+	if l, ok := r.limiters[serviceFamily(host)]; ok {
+		return l
+	}
+	return r.limiters[RateLimitDefault]
+}
+
+// serviceFamily returns the service family of host, or RateLimitDefault.
+func serviceFamily(host string) string {
+	for _, label := range strings.Split(host, ".") {
+		if family, ok := serviceFamilyLabels[label]; ok {
+			return family
+		}
+	}
+	return RateLimitDefault
+}
+
+// Backoff returns the delay before the retry of a request after attempt
+// failed attempts: an exponential backoff from base, capped at
+// RetryAPIMaxDelay, with a random jitter of up to half of the delay.
+func Backoff(attempt int, base time.Duration) time.Duration {
+	delay := RetryAPIMaxDelay
+	if attempt < 30 && base<<attempt > 0 && base<<attempt < RetryAPIMaxDelay {
+		delay = base << attempt
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// retryBackoff is the backoff of the retryable clients of the go-sdk-core
+// services, the Backoff of the provider honouring Retry-After.
+func retryBackoff(_, _ time.Duration, attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := retryAfter(resp); ok {
+			return delay
+		}
+	}
+	return Backoff(attempt, rateLimitBaseDelay)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp,
+// given in seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// rateLimitTransport is the http.RoundTripper shared by the service clients
+// to apply the rateLimiter of the provider.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	limiter   *rateLimiter
+	// retry is whether the requests rejected with 429 Too Many Requests are
+	// retried by the transport, for the clients without a retry layer.
+	retry bool
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	limiter := t.limiter.limiter(req.URL.Hostname())
+	for attempt := 0; ; attempt++ {
+		if limiter != nil {
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || !t.retry || resp.StatusCode != http.StatusTooManyRequests || attempt >= t.limiter.maxRetries {
+			return resp, err
+		}
+		// The body of the request can only be sent again if it can be rewound.
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, err
+		}
+
+		delay, ok := retryAfter(resp)
+		if !ok {
+			delay = Backoff(attempt, rateLimitBaseDelay)
+		}
+		log.Printf("[DEBUG] %s %s was rate limited, retrying in %s", req.Method, req.URL.Path, delay)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
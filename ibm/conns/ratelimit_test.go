@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestServiceFamily(t *testing.T) {
+	for host, family := range map[string]string{
+		"iam.cloud.ibm.com":                                "iam",
+		"us-south.iaas.cloud.ibm.com":                      "vpc",
+		"us-south.power-iaas.cloud.ibm.com":                "power",
+		"private.us-south.kms.cloud.ibm.com":               "kms",
+		"s3.us-south.cloud-object-storage.appdomain.cloud": "cos",
+		"api.softlayer.com":                                "classic",
+		"example.com":                                      RateLimitDefault,
+	} {
+		if got := serviceFamily(host); got != family {
+			t.Errorf("serviceFamily(%q) returned %q, expected %q", host, got, family)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		for n := 0; n < 20; n++ {
+			if delay := Backoff(attempt, time.Second); delay < max/2 || delay > max {
+				t.Fatalf("Backoff(%d) returned %s, expected between %s and %s", attempt, delay, max/2, max)
+			}
+		}
+	}
+	if delay := Backoff(100, time.Second); delay < RetryAPIMaxDelay/2 || delay > RetryAPIMaxDelay {
+		t.Fatalf("Backoff(100) returned %s, expected at most %s", delay, RetryAPIMaxDelay)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	if _, ok := retryAfter(resp); ok {
+		t.Fatal("retryAfter returned a delay without a Retry-After header")
+	}
+	resp.Header.Set("Retry-After", "3")
+	if delay, ok := retryAfter(resp); !ok || delay != 3*time.Second {
+		t.Fatalf("retryAfter returned %s, expected 3s", delay)
+	}
+	resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	if delay, ok := retryAfter(resp); !ok || delay < 59*time.Minute {
+		t.Fatalf("retryAfter returned %s, expected about 1h", delay)
+	}
+}
+
+func TestRateLimitTransportRetry(t *testing.T) {
+	var bodies []string
+	transport := &rateLimitTransport{
+		limiter: newRateLimiter(&Config{RetryCount: 2}),
+		retry:   true,
+		transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			var body []byte
+			if req.Body != nil {
+				body, _ = io.ReadAll(req.Body)
+			}
+			bodies = append(bodies, string(body))
+			resp := &http.Response{StatusCode: 200, Header: http.Header{}, Body: http.NoBody, Request: req}
+			if len(bodies) < 3 {
+				resp.StatusCode = http.StatusTooManyRequests
+				resp.Header.Set("Retry-After", "0")
+			}
+			return resp, nil
+		}),
+	}
+	req, _ := http.NewRequest("POST", "https://us-south.iaas.cloud.ibm.com/v1/vpcs", strings.NewReader(`{"name":"test-vpc"}`))
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != 200 || len(bodies) != 3 {
+		t.Fatalf("RoundTrip returned %d after %d requests, expected 200 after 3", resp.StatusCode, len(bodies))
+	}
+	for _, body := range bodies {
+		if body != `{"name":"test-vpc"}` {
+			t.Fatalf("Retried request was sent with body %q", body)
+		}
+	}
+
+	bodies = nil
+	transport.limiter.maxRetries = 1
+	req, _ = http.NewRequest("GET", "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+	if resp, _ = transport.RoundTrip(req); resp.StatusCode != http.StatusTooManyRequests || len(bodies) != 2 {
+		t.Fatalf("RoundTrip returned %d after %d requests, expected 429 after 2", resp.StatusCode, len(bodies))
+	}
+
+	// The clients with a retry layer of their own retry the requests.
+	bodies = nil
+	transport.retry = false
+	req, _ = http.NewRequest("GET", "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+	if resp, _ = transport.RoundTrip(req); resp.StatusCode != http.StatusTooManyRequests || len(bodies) != 1 {
+		t.Fatalf("RoundTrip returned %d after %d requests, expected 429 after 1", resp.StatusCode, len(bodies))
+	}
+}
+
+func TestServiceRetryBackoff(t *testing.T) {
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "https://us-south.iaas.cloud.ibm.com/v1", Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.EnableRetries(3, 5*time.Second)
+	c := &Config{RetryCount: 3}
+	c.rateLimiter = newRateLimiter(c)
+	c.wrapServiceTransport(service)
+
+	tr := service.Client.Transport.(*retryablehttp.RoundTripper)
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if delay := tr.Client.Backoff(time.Second, 5*time.Second, 0, resp); delay != 7*time.Second {
+		t.Errorf("The retryable client waits %s, expected the 7s of Retry-After", delay)
+	}
+	if delay := tr.Client.Backoff(time.Second, 5*time.Second, 4, nil); delay < 8*time.Second {
+		t.Errorf("The retryable client waits %s after 4 attempts, expected the backoff of the provider", delay)
+	}
+	if _, ok := tr.Client.HTTPClient.Transport.(*rateLimitTransport); !ok {
+		t.Errorf("The requests of the retryable client are not rate limited")
+	}
+}
+
+func TestRateLimitTransportLimit(t *testing.T) {
+	var requests int
+	transport := &rateLimitTransport{
+		limiter: newRateLimiter(&Config{RateLimits: map[string]RateLimit{"vpc": {RequestsPerSecond: 20, Burst: 1}}}),
+		transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 200, Body: http.NoBody, Request: req}, nil
+		}),
+	}
+	start := time.Now()
+	for n := 0; n < 5; n++ {
+		req, _ := http.NewRequest("GET", "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("5 requests at 20 per second took %s", elapsed)
+	}
+
+	start = time.Now()
+	for n := 0; n < 5; n++ {
+		req, _ := http.NewRequest("GET", "https://iam.cloud.ibm.com/identity/token", nil)
+		transport.RoundTrip(req)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("5 requests to a service without a rate limit took %s", elapsed)
+	}
+}
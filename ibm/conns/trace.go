@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//
+// HTTP request tracing.
+//
+// When a trace file is configured, every API call of the service clients is
+// appended to it as a JSON line, attributed to the Terraform operation it was
+// made for. Only the method, the templated path and the response metadata of
+// the calls are traced; headers, query strings and bodies, which may hold
+// credentials, never are. A last line summarizes the slowest operations when
+// the provider exits, see CloseTrace.
+//
+
+// traceSummarySize is the number of operations listed in the summary.
+const traceSummarySize = 20
+
+var (
+	traceMu sync.Mutex
+	trace   *tracer
+
+	// traceIDRegexp matches the path segments which are identifiers of
+	// resources: UUIDs, CRNs, numbers and the IDs of the VPC resources.
+	traceIDRegexp = regexp.MustCompile(`^([0-9]+|crn(:|%3A).*|[0-9a-fA-F]{8}-[0-9a-fA-F-]{27,}|[0-9a-z]{4}-[0-9a-fA-F]{8}-[0-9a-fA-F-]{27,}|[0-9a-fA-F]{32})$`)
+)
+
+// traceEntry is a line of the trace.
+type traceEntry struct {
+	Type          string  `json:"type"`
+	Time          string  `json:"time"`
+	Resource      string  `json:"resource,omitempty"`
+	DataSource    bool    `json:"data_source,omitempty"`
+	Operation     string  `json:"operation,omitempty"`
+	ID            string  `json:"id,omitempty"`
+	Service       string  `json:"service,omitempty"`
+	Method        string  `json:"method,omitempty"`
+	URL           string  `json:"url,omitempty"`
+	Status        int     `json:"status,omitempty"`
+	Error         string  `json:"error,omitempty"`
+	LatencyMS     float64 `json:"latency_ms"`
+	Retries       int     `json:"retries,omitempty"`
+	Requests      int64   `json:"requests,omitempty"`
+	CorrelationID string  `json:"correlation_id,omitempty"`
+}
+
+// traceOperation is a Terraform operation of a resource or data source.
+type traceOperation struct {
+	resource   string
+	dataSource bool
+	operation  string
+	start      time.Time
+	requests   int64
+}
+
+type traceOperationKey struct{}
+
+// tracer writes the trace of the provider to a file.
+type tracer struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+	enc  *json.Encoder
+
+	// inflight are the operations in progress, the requests made without
+	// their context are attributed to the only one in progress, if any.
+	inflight map[*traceOperation]struct{}
+	// attempts counts the consecutive failed attempts of each request of the
+	// operations, to report the retries of the service clients.
+	attempts   map[string]int
+	operations []traceEntry
+}
+
+// startTrace starts appending the trace of the provider to path. All the
+// configurations of the provider in a process share the first trace file.
+func startTrace(path string) error {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	if trace != nil {
+		if trace.path != path {
+			log.Printf("[WARN] The HTTP requests are already traced to %s, ignoring %s", trace.path, path)
+		}
+		return nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening the HTTP trace file %s: %s", path, err)
+	}
+	trace = &tracer{
+		path:     path,
+		file:     file,
+		enc:      json.NewEncoder(file),
+		inflight: map[*traceOperation]struct{}{},
+		attempts: map[string]int{},
+	}
+	return nil
+}
+
+func currentTracer() *tracer {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	return trace
+}
+
+// StartTraceOperation returns ctx with the operation of resource, and the
+// function ending the operation, which must be called with the ID of the
+// resource once done. It does nothing when tracing is disabled.
+func StartTraceOperation(ctx context.Context, resource, operation string, dataSource bool) (context.Context, func(id string)) {
+	t := currentTracer()
+	if t == nil {
+		return ctx, func(string) {}
+	}
+	op := &traceOperation{
+		resource:   resource,
+		dataSource: dataSource,
+		operation:  operation,
+		start:      time.Now(),
+	}
+	t.mu.Lock()
+	t.inflight[op] = struct{}{}
+	t.mu.Unlock()
+
+	return context.WithValue(ctx, traceOperationKey{}, op), func(id string) {
+		entry := traceEntry{
+			Type:       "operation",
+			Time:       op.start.UTC().Format(time.RFC3339Nano),
+			Resource:   op.resource,
+			DataSource: op.dataSource,
+			Operation:  op.operation,
+			ID:         id,
+			LatencyMS:  milliseconds(time.Since(op.start)),
+			Requests:   atomic.LoadInt64(&op.requests),
+		}
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.inflight, op)
+		prefix := fmt.Sprintf("%p ", op)
+		for key := range t.attempts {
+			if strings.HasPrefix(key, prefix) {
+				delete(t.attempts, key)
+			}
+		}
+		t.operations = append(t.operations, entry)
+		t.write(entry)
+	}
+}
+
+// operation returns the operation a request was made for.
+func (t *tracer) operation(req *http.Request) *traceOperation {
+	if op, ok := req.Context().Value(traceOperationKey{}).(*traceOperation); ok {
+		return op
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.inflight) == 1 {
+		for op := range t.inflight {
+			return op
+		}
+	}
+	return nil
+}
+
+// write writes entry to the trace, t.mu must be held.
+func (t *tracer) write(entry interface{}) {
+	if err := t.enc.Encode(entry); err != nil {
+		log.Printf("[WARN] Error writing the HTTP trace: %s", err)
+	}
+}
+
+// CloseTrace writes the summary of the slowest operations to the trace and
+// to the log, and closes the trace file.
+func CloseTrace() {
+	traceMu.Lock()
+	t := trace
+	trace = nil
+	traceMu.Unlock()
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	operations := t.operations
+	sort.SliceStable(operations, func(i, j int) bool {
+		return operations[i].LatencyMS > operations[j].LatencyMS
+	})
+	if len(operations) > traceSummarySize {
+		operations = operations[:traceSummarySize]
+	}
+	var summary strings.Builder
+	for _, op := range operations {
+		fmt.Fprintf(&summary, "\n  %10.1fs  %-6s %s %s (%d requests)", op.LatencyMS/1000, op.Operation, op.Resource, op.ID, op.Requests)
+	}
+	log.Printf("[INFO] Slowest operations:%s", summary.String())
+	t.write(struct {
+		Type       string       `json:"type"`
+		Time       string       `json:"time"`
+		Operations []traceEntry `json:"slowest_operations"`
+	}{"summary", time.Now().UTC().Format(time.RFC3339Nano), operations})
+	t.file.Close()
+}
+
+// traceURL returns the URL of req without its query, credentials and the
+// identifiers in its path, which are replaced by {id}.
+func traceURL(req *http.Request) string {
+	segments := strings.Split(req.URL.EscapedPath(), "/")
+	for i, segment := range segments {
+		if traceIDRegexp.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return fmt.Sprintf("%s://%s%s", req.URL.Scheme, req.URL.Host, strings.Join(segments, "/"))
+}
+
+// traceService returns the service family of host, or host itself.
+func traceService(host string) string {
+	if family := serviceFamily(host); family != RateLimitDefault {
+		return family
+	}
+	return host
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// traceTransport traces the requests of the wrapped transport.
+type traceTransport struct {
+	transport http.RoundTripper
+	tracer    *tracer
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	op := t.tracer.operation(req)
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	latency := time.Since(start)
+
+	entry := traceEntry{
+		Type:          "request",
+		Time:          start.UTC().Format(time.RFC3339Nano),
+		Service:       traceService(req.URL.Hostname()),
+		Method:        req.Method,
+		URL:           traceURL(req),
+		LatencyMS:     milliseconds(latency),
+		CorrelationID: req.Header.Get("X-Correlation-ID"),
+	}
+	if err != nil {
+		// The error of the client holds the URL of the request, query included.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			entry.Error = urlErr.Err.Error()
+		} else {
+			entry.Error = err.Error()
+		}
+	} else {
+		entry.Status = resp.StatusCode
+		for _, header := range []string{"X-Correlation-ID", "X-Request-ID", "X-Global-Transaction-ID"} {
+			if id := resp.Header.Get(header); id != "" {
+				entry.CorrelationID = id
+				break
+			}
+		}
+	}
+	if op != nil {
+		atomic.AddInt64(&op.requests, 1)
+		entry.Resource = op.resource
+		entry.DataSource = op.dataSource
+		entry.Operation = op.operation
+	}
+
+	// A request is a retry when the previous attempt of the same request of
+	// the operation failed with an error or a retryable status.
+	key := fmt.Sprintf("%p %s %s", op, req.Method, req.URL.String())
+	t.tracer.mu.Lock()
+	defer t.tracer.mu.Unlock()
+	entry.Retries = t.tracer.attempts[key]
+	if err != nil || isRetryableStatusCode(resp.StatusCode) {
+		t.tracer.attempts[key]++
+	} else {
+		delete(t.tracer.attempts, key)
+	}
+	t.tracer.write(entry)
+	return resp, err
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/runtime/client"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// TransportWrapper, when set, wraps the HTTP transport of the service clients
+// constructed by ClientSession. It must be set before the provider is
+// configured; the acceptance tests use it to record and replay the HTTP
+// interactions of the provider.
+var TransportWrapper func(http.RoundTripper) http.RoundTripper
+
+// wrapsTransport reports whether the transports of the service clients of c
+// need to be wrapped.
+func (c *Config) wrapsTransport() bool {
+	return TransportWrapper != nil || c.rateLimiter != nil || c.authenticator != nil || currentTracer() != nil
+}
+
+// wrapTransport returns transport wrapped by the TransportWrapper, by the
+// tracer of the requests, by the rate limiter shared by the service clients of
+// c and by the refresh of the token of its compute resource authenticator.
+// The client of transport retries the requests rejected by rate limiting.
+func (c *Config) wrapTransport(transport http.RoundTripper) http.RoundTripper {
+	return c.wrapTransportRetrying(transport, false)
+}
+
+// wrapTransportRetrying returns transport wrapped like wrapTransport, which
+// also retries the requests rejected by rate limiting when retry is set.
+func (c *Config) wrapTransportRetrying(transport http.RoundTripper, retry bool) http.RoundTripper {
+	if !c.wrapsTransport() {
+		return transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if TransportWrapper != nil {
+		transport = TransportWrapper(transport)
+	}
+	if t := currentTracer(); t != nil {
+		transport = &traceTransport{
+			transport: transport,
+			tracer:    t,
+		}
+	}
+	if c.rateLimiter != nil {
+		transport = &rateLimitTransport{
+			transport: transport,
+			limiter:   c.rateLimiter,
+			retry:     retry,
+		}
+	}
+	if c.authenticator != nil {
+		transport = &tokenTransport{
+			transport:     transport,
+			authenticator: c.authenticator,
+			token:         strings.TrimPrefix(c.IAMToken, "Bearer "),
+		}
+	}
+	return transport
+}
+
+// wrapHTTPClient returns an HTTP client using the wrapped transport of
+// client, or client itself when there is nothing to wrap it with.
+func (c *Config) wrapHTTPClient(client *http.Client) *http.Client {
+	if !c.wrapsTransport() {
+		return client
+	}
+	if client == nil {
+		client = &http.Client{}
+	}
+	wrapped := *client
+	wrapped.Transport = c.wrapTransport(client.Transport)
+	return &wrapped
+}
+
+// wrapServiceTransport wraps the transport of the HTTP client used by
+// service for the individual requests, which is the client embedded in the
+// retryable client when retries are enabled. The retryable client retries
+// with the Backoff of the provider.
+func (c *Config) wrapServiceTransport(service *core.BaseService) {
+	if service == nil {
+		return
+	}
+	if service.Client != nil {
+		if tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+			tr.Client.Backoff = retryBackoff
+		}
+	}
+	if !c.wrapsTransport() {
+		return
+	}
+	if service.GetHTTPClient() == nil {
+		service.SetHTTPClient(core.DefaultHTTPClient())
+	}
+	httpClient := service.GetHTTPClient()
+	httpClient.Transport = c.wrapTransport(httpClient.Transport)
+}
+
+// wrapRuntimeTransport wraps the transport of a go-openapi client runtime,
+// as used by the Power Systems client. The runtime does not retry, so the
+// rate limiter retries the requests rejected by rate limiting.
+func (c *Config) wrapRuntimeTransport(transport interface{}) {
+	if rt, ok := transport.(*client.Runtime); ok {
+		rt.Transport = c.wrapTransportRetrying(rt.Transport, true)
+	}
+}
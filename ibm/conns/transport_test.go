@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransportWrapper(t *testing.T) {
+	var requests []string
+	TransportWrapper = func(http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"id":"r006-test","name":"test-vpc"}`)),
+				Request:    req,
+			}, nil
+		})
+	}
+	defer func() { TransportWrapper = nil }()
+
+	client, err := testClientSession().VpcV1API()
+	if err != nil {
+		t.Fatalf("VpcV1API returned an error: %s", err)
+	}
+	vpc, _, err := client.GetVPC(&vpcv1.GetVPCOptions{ID: core.StringPtr("r006-test")})
+	if err != nil {
+		t.Fatalf("GetVPC returned an error: %s", err)
+	}
+	if *vpc.Name != "test-vpc" {
+		t.Fatalf("GetVPC returned %s, expected the response of the wrapped transport", *vpc.Name)
+	}
+	if len(requests) != 1 || requests[0] != "GET /v1/vpcs/r006-test" {
+		t.Fatalf("Wrapped transport received %v", requests)
+	}
+}
+
+func TestTransportWrapperUnset(t *testing.T) {
+	c := &Config{}
+	client := &http.Client{}
+	if c.wrapHTTPClient(client) != client {
+		t.Fatal("wrapHTTPClient changed the client without a TransportWrapper")
+	}
+	if c.wrapHTTPClient(nil) != nil {
+		t.Fatal("wrapHTTPClient built a client without a TransportWrapper")
+	}
+}
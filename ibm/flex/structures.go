@@ -2836,17 +2836,13 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	if newList == nil {
 		newList = new(schema.Set)
 	}
-	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
-	removeInt := olds.Difference(news).List()
-	addInt := news.Difference(olds).List()
-	add := make([]string, len(addInt))
-	for i, v := range addInt {
-		add[i] = fmt.Sprint(v)
-	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
+	olds := tagsWithHash(oldList.(*schema.Set))
+	news := tagsWithHash(newList.(*schema.Set))
+	defaultTags := providerDefaultTags(meta, tagType)
+	add, remove := tagsDifference(olds, news, defaultTags)
+	desired := news
+	if len(defaultTags) > 0 {
+		desired = news.Union(NewStringSet(news.F, defaultTags))
 	}
 
 	if strings.TrimSpace(tagType) == "" || tagType == "user" {
@@ -2901,7 +2897,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating database tags %v : %s\n%s", add, err, resp)
 		}
-		response, errored := WaitForTagsAvailable(meta, resourceID, resourceType, tagType, news, 30*time.Second)
+		response, errored := WaitForTagsAvailable(meta, resourceID, resourceType, tagType, desired, 30*time.Second)
 		if errored != nil {
 			log.Printf(`[ERROR] Error waiting for resource tags %s : %v
 %v`, resourceID, errored, response)
@@ -2986,18 +2982,9 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	if newList == nil {
 		newList = new(schema.Set)
 	}
-	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
-	removeInt := olds.Difference(news).List()
-	addInt := news.Difference(olds).List()
-	add := make([]string, len(addInt))
-	for i, v := range addInt {
-		add[i] = fmt.Sprint(v)
-	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
-	}
+	olds := tagsWithHash(oldList.(*schema.Set))
+	news := tagsWithHash(newList.(*schema.Set))
+	add, remove := tagsDifference(olds, news, providerDefaultTags(meta, "user"))
 
 	schematicTags := os.Getenv("IC_ENV_TAGS")
 	var envTags []string
@@ -3117,6 +3104,82 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 	}
 	return nil
 }
+
+// tagsWithHash returns s with a hash function, as the empty sets made by new() have
+// none.
+func tagsWithHash(s *schema.Set) *schema.Set {
+	if s.F == nil {
+		return NewStringSet(ResourceIBMVPCHash, ExpandStringList(s.List()))
+	}
+	return s
+}
+
+// providerDefaultTags returns the default tags of the provider of tagType, or
+// none when meta is not a client session.
+func providerDefaultTags(meta interface{}, tagType string) []string {
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	return session.DefaultTags(tagType)
+}
+
+// tagsDifference returns the tags to attach and detach to go from olds to
+// news. The default tags of the provider are attached when missing and are
+// never detached.
+func tagsDifference(olds, news *schema.Set, defaultTags []string) (add, remove []string) {
+	for _, v := range news.Difference(olds).List() {
+		add = append(add, fmt.Sprint(v))
+	}
+	for _, v := range defaultTags {
+		if !olds.Contains(v) && !news.Contains(v) {
+			add = append(add, v)
+		}
+	}
+	defaults := NewStringSet(news.F, defaultTags)
+	for _, v := range olds.Difference(news).List() {
+		if !defaults.Contains(v) {
+			remove = append(remove, fmt.Sprint(v))
+		}
+	}
+	return add, remove
+}
+
+// ResourceDefaultTagsCustomizeDiff suppresses the diff of the tags attribute
+// key when it only detaches default tags of the provider, and plans allKey as
+// the tags of the resource including the default tags. Only a computed key can
+// be cleared, the default tags are kept out of the others when they are read.
+func ResourceDefaultTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}, key, allKey, tagType string, computed bool) error {
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	defaultTags := session.DefaultTags(tagType)
+
+	if computed && diff.Id() != "" && diff.HasChange(key) {
+		o, n := diff.GetChange(key)
+		oldSet := tagsWithHash(o.(*schema.Set))
+		newSet := tagsWithHash(n.(*schema.Set))
+		defaults := NewStringSet(newSet.F, defaultTags)
+		if newSet.Difference(oldSet).Len() == 0 && oldSet.Difference(newSet).Difference(defaults).Len() == 0 {
+			log.Printf("[DEBUG] Suppressing the diff of %s detaching the default tags %v", key, defaultTags)
+			if err := diff.Clear(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !diff.NewValueKnown(key) {
+		return diff.SetNewComputed(allKey)
+	}
+	tags := tagsWithHash(diff.Get(key).(*schema.Set))
+	all := tags.Union(NewStringSet(tags.F, defaultTags))
+	if old, ok := diff.Get(allKey).(*schema.Set); ok && old.Equal(all) {
+		return nil
+	}
+	return diff.SetNew(allKey, all.List())
+}
+
 func ResourcePowerUserTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 
 	if diff.Id() != "" && diff.HasChange("pi_user_tags") {
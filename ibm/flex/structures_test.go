@@ -6,6 +6,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -84,3 +85,17 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestTagsDifference(t *testing.T) {
+	olds := NewStringSet(ResourceIBMVPCHash, []string{"env:dev", "team:a", "owner:finops"})
+	news := tagsWithHash(&schema.Set{})
+	news.Add("env:prod")
+	news.Add("team:a")
+	add, remove := tagsDifference(olds, news, []string{"owner:finops", "cost-center:42"})
+	assert.ElementsMatch(t, []string{"env:prod", "cost-center:42"}, add)
+	assert.ElementsMatch(t, []string{"env:dev"}, remove)
+
+	add, remove = tagsDifference(tagsWithHash(&schema.Set{}), news, nil)
+	assert.ElementsMatch(t, []string{"env:prod", "team:a"}, add)
+	assert.Empty(t, remove)
+}
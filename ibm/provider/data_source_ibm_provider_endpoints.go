@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMProviderEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMProviderEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"services": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The endpoint keys of the services to report, for example IBMCLOUD_IS_NG_API_ENDPOINT. Defaults to all the services.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the provider the endpoints are resolved for.",
+			},
+			"visibility": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The visibility of the provider the endpoints are resolved for.",
+			},
+			"endpoints_file_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The endpoints file of the provider.",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The endpoints of the services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The endpoint key of the service.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The endpoint used for the service.",
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Where the endpoint comes from: env, endpoints_file or default.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMProviderEndpointsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	session := meta.(conns.ClientSession)
+	bxSession, err := session.BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var services []string
+	if v, ok := d.GetOk("services"); ok {
+		services = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	serviceEndpoints, err := session.ServiceEndpoints(services)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	endpoints := make([]map[string]interface{}, 0, len(serviceEndpoints))
+	for _, endpoint := range serviceEndpoints {
+		endpoints = append(endpoints, map[string]interface{}{
+			"service": endpoint.Key,
+			"url":     endpoint.URL,
+			"source":  endpoint.Source,
+		})
+	}
+	endpointsFile := conns.EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, bxSession.Config.EndpointsFile)
+
+	d.SetId(fmt.Sprintf("%s/%s", bxSession.Config.Region, bxSession.Config.Visibility))
+	d.Set("region", bxSession.Config.Region)
+	d.Set("visibility", bxSession.Config.Visibility)
+	d.Set("endpoints_file_path", endpointsFile)
+	if err := d.Set("endpoints", endpoints); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting endpoints: %s", err))
+	}
+	return nil
+}
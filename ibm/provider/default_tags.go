@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultTagsAttribute is a tags attribute of a resource receiving the
+// default_tags of the provider, and its computed counterpart with all the tags
+// of the resource.
+type defaultTagsAttribute struct {
+	key     string
+	allKey  string
+	tagType string
+	// computed is whether the tags attribute is computed, the default tags
+	// are otherwise kept out of it when the resource is read.
+	computed bool
+}
+
+var defaultTagsAttributes = []defaultTagsAttribute{
+	{key: "tags", allKey: "tags_all", tagType: "user"},
+	{key: "access_tags", allKey: "access_tags_all", tagType: "access"},
+	// The user tags of the Power resources
+	{key: "pi_user_tags", allKey: "pi_user_tags_all", tagType: "user"},
+}
+
+// taggedAttributes returns the tags attributes of a resource which can receive
+// the default tags, that is the sets of global tags of a resource with a CRN.
+func taggedAttributes(resource *schema.Resource) []defaultTagsAttribute {
+	if resource.Schema["crn"] == nil && resource.Schema[flex.ResourceCRN] == nil {
+		return nil
+	}
+	var attributes []defaultTagsAttribute
+	for _, attribute := range defaultTagsAttributes {
+		s := resource.Schema[attribute.key]
+		if s == nil || s.Type != schema.TypeSet || !s.Optional || resource.Schema[attribute.allKey] != nil {
+			continue
+		}
+		if elem, ok := s.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+			continue
+		}
+		attribute.computed = s.Computed
+		attributes = append(attributes, attribute)
+	}
+	return attributes
+}
+
+// withDefaultTags returns a copy of resource computing tags_all and
+// access_tags_all, which attaches the default tags of the provider on create
+// and update.
+func withDefaultTags(resource *schema.Resource) *schema.Resource {
+	attributes := taggedAttributes(resource)
+	if len(attributes) == 0 {
+		return resource
+	}
+
+	r := *resource
+	r.Schema = make(map[string]*schema.Schema, len(resource.Schema)+len(attributes))
+	for k, v := range resource.Schema {
+		r.Schema[k] = v
+	}
+	for _, attribute := range attributes {
+		r.Schema[attribute.allKey] = &schema.Schema{
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         flex.ResourceIBMVPCHash,
+			Description: "The " + attribute.key + " of the resource, including the default tags of the provider.",
+		}
+	}
+
+	apply := func(operation string) func(d *schema.ResourceData, meta interface{}, prior map[string]*schema.Set) error {
+		return func(d *schema.ResourceData, meta interface{}, prior map[string]*schema.Set) error {
+			return applyDefaultTags(d, meta, operation, attributes, prior)
+		}
+	}
+	r.CreateContext = defaultTagsContextFunc(resource.CreateContext, attributes, apply("create"))
+	r.ReadContext = defaultTagsContextFunc(resource.ReadContext, attributes, apply("read"))
+	r.UpdateContext = defaultTagsContextFunc(resource.UpdateContext, attributes, apply("update"))
+	r.CreateWithoutTimeout = defaultTagsContextFunc(resource.CreateWithoutTimeout, attributes, apply("create"))
+	r.ReadWithoutTimeout = defaultTagsContextFunc(resource.ReadWithoutTimeout, attributes, apply("read"))
+	r.UpdateWithoutTimeout = defaultTagsContextFunc(resource.UpdateWithoutTimeout, attributes, apply("update"))
+	r.Create = defaultTagsFunc(resource.Create, attributes, apply("create"))
+	r.Read = defaultTagsFunc(resource.Read, attributes, apply("read"))
+	r.Update = defaultTagsFunc(resource.Update, attributes, apply("update"))
+
+	customizeDiff := resource.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+		for _, attribute := range attributes {
+			if err := flex.ResourceDefaultTagsCustomizeDiff(diff, meta, attribute.key, attribute.allKey, attribute.tagType, attribute.computed); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return &r
+}
+
+func defaultTagsContextFunc(
+	function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	attributes []defaultTagsAttribute,
+	apply func(*schema.ResourceData, interface{}, map[string]*schema.Set) error,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if function == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		prior := priorTags(d, attributes)
+		diags := function(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		if err := apply(d, meta, prior); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func defaultTagsFunc(
+	function func(*schema.ResourceData, interface{}) error,
+	attributes []defaultTagsAttribute,
+	apply func(*schema.ResourceData, interface{}, map[string]*schema.Set) error,
+) func(*schema.ResourceData, interface{}) error {
+	if function == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		prior := priorTags(d, attributes)
+		if err := function(d, meta); err != nil {
+			return err
+		}
+		return apply(d, meta, prior)
+	}
+}
+
+// priorTags returns the tags of the attributes before an operation of the
+// resource, from its configuration or state.
+func priorTags(d *schema.ResourceData, attributes []defaultTagsAttribute) map[string]*schema.Set {
+	prior := make(map[string]*schema.Set, len(attributes))
+	for _, attribute := range attributes {
+		if tags, ok := d.Get(attribute.key).(*schema.Set); ok {
+			prior[attribute.key] = flex.NewStringSet(flex.ResourceIBMVPCHash, flex.ExpandStringList(tags.List()))
+		}
+	}
+	return prior
+}
+
+// applyDefaultTags attaches the default tags missing from a created or
+// updated resource, and sets the computed attributes with all its tags. The
+// default tags read into a tags attribute which is not computed are removed
+// from it unless they were configured, as its diff cannot be suppressed.
+func applyDefaultTags(d *schema.ResourceData, meta interface{}, operation string, attributes []defaultTagsAttribute, prior map[string]*schema.Set) error {
+	session, ok := meta.(conns.ClientSession)
+	if !ok || d.Id() == "" {
+		return nil
+	}
+	crn, _ := d.Get("crn").(string)
+	if crn == "" {
+		crn, _ = d.Get(flex.ResourceCRN).(string)
+	}
+
+	for _, attribute := range attributes {
+		tags, ok := d.Get(attribute.key).(*schema.Set)
+		if !ok {
+			continue
+		}
+		all := flex.NewStringSet(flex.ResourceIBMVPCHash, flex.ExpandStringList(tags.List()))
+		if operation != "read" && crn != "" {
+			var missing []string
+			for _, tag := range session.DefaultTags(attribute.tagType) {
+				if !all.Contains(tag) {
+					missing = append(missing, tag)
+				}
+			}
+			if len(missing) > 0 {
+				log.Printf("[DEBUG] Attaching the default %s %v to %s", attribute.key, missing, crn)
+				if err := flex.UpdateGlobalTagsUsingCRN(all, all, meta, crn, "", attribute.tagType); err != nil {
+					return err
+				}
+				for _, tag := range missing {
+					all.Add(tag)
+				}
+			}
+		}
+		if err := d.Set(attribute.allKey, all); err != nil {
+			return err
+		}
+		if !attribute.computed && prior[attribute.key] != nil {
+			kept := flex.NewStringSet(flex.ResourceIBMVPCHash, nil)
+			defaults := flex.NewStringSet(flex.ResourceIBMVPCHash, session.DefaultTags(attribute.tagType))
+			for _, tag := range flex.ExpandStringList(tags.List()) {
+				if !defaults.Contains(tag) || prior[attribute.key].Contains(tag) {
+					kept.Add(tag)
+				}
+			}
+			if err := d.Set(attribute.key, kept); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
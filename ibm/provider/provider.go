@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -71,6 +72,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vmware"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 )
 
 // Provider returns a *schema.Provider.
@@ -179,6 +181,119 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The client-side rate limit of the API calls to a family of services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues(conns.RateLimitServiceFamilies),
+							Description:  "The family of services the rate limit applies to, or default for all the other services.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0.01),
+							Description:  "The number of API calls per second.",
+						},
+						"burst": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The number of API calls which can be made at once. Defaults to requests_per_second rounded up.",
+						},
+					},
+				},
+			},
+			"deletion_protection": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules preventing the deletion of resources by type or by tag.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the rule, reported when it prevents a deletion.",
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The types of the protected resources, for example ibm_database or ibm_is_*.",
+						},
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The resources with one of these tags are protected, for example env:prod.",
+						},
+					},
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags attached to every resource supporting tags or access_tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "The user tags attached to every resource with tags.",
+						},
+						"access_tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "The access management tags attached to every resource with access_tags.",
+						},
+					},
+				},
+			},
+			"poll_interval": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The interval between the polls of the resources waiting for an object to reach a state.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The types of the resources the interval applies to, for example ibm_is_instance or ibm_is_*.",
+						},
+						"min": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The delay before the first poll, and after a change of state, for example 5s.",
+						},
+						"max": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The delay the interval backs off to while the state does not change, for example 1m. Defaults to min.",
+						},
+					},
+				},
+			},
+			"http_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The file the API calls of the provider are traced to, as JSON lines.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_HTTP_TRACE_FILE", "IBMCLOUD_HTTP_TRACE_FILE"}, nil),
+			},
+			"drift_report_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The file the arguments of the resources which drifted from the state on refresh are reported to, as JSON lines.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_DRIFT_REPORT_FILE", "IBMCLOUD_DRIFT_REPORT_FILE"}, nil),
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -206,6 +321,61 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"iam_profile_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IAM Trusted Profile name, as an alternative to iam_profile_id with auth_type container",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME"}, nil),
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues(conns.AuthTypes),
+				Description:  "Authenticate with the identity of the compute resource (container or vpc) or with the session of the ibmcloud CLI (cli) instead of an API key or token",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_AUTH_TYPE", "IBMCLOUD_AUTH_TYPE"}, nil),
+			},
+			"cr_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The compute resource token file of auth_type container",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CR_TOKEN_FILE", "IBMCLOUD_CR_TOKEN_FILE"}, nil),
+			},
+			"cli_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The config.json file of the ibmcloud CLI used by auth_type cli",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CLI_CONFIG_FILE", "IBMCLOUD_CLI_CONFIG_FILE"}, nil),
+			},
+			"assume_trusted_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The trusted profile, possibly in another account, whose identity is assumed with the credentials of the provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the trusted profile.",
+						},
+						"profile_crn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the trusted profile.",
+						},
+						"profile_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the trusted profile, in the account given by account_id.",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The account of the trusted profile given by profile_name.",
+						},
+					},
+				},
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -302,6 +472,7 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                        functions.DataSourceIBMFunctionNamespace(),
 			"ibm_cis":                                       cis.DataSourceIBMCISInstance(),
 			"ibm_cis_dns_records":                           cis.DataSourceIBMCISDNSRecords(),
+			"ibm_cis_dns_zone_file":                         cis.DataSourceIBMCISDNSZoneFile(),
 			"ibm_cis_certificates":                          cis.DataSourceIBMCISCertificates(),
 			"ibm_cis_global_load_balancers":                 cis.DataSourceIBMCISGlbs(),
 			"ibm_cis_origin_pools":                          cis.DataSourceIBMCISOriginPools(),
@@ -403,6 +574,7 @@ func Provider() *schema.Provider {
 			"ibm_hpcs_vault":                                hpcs.DataSourceIbmVault(),
 			"ibm_iam_access_group":                          iamaccessgroup.DataSourceIBMIAMAccessGroup(),
 			"ibm_iam_access_group_policy":                   iampolicy.DataSourceIBMIAMAccessGroupPolicy(),
+			"ibm_iam_access_check":                          iampolicy.DataSourceIBMIAMAccessCheck(),
 			"ibm_iam_access_group_template_versions":        iamaccessgroup.DataSourceIBMIAMAccessGroupTemplateVersions(),
 			"ibm_iam_access_group_template_assignment":      iamaccessgroup.DataSourceIBMIAMAccessGroupTemplateAssignment(),
 			"ibm_iam_account_settings":                      iamidentity.DataSourceIBMIAMAccountSettings(),
@@ -715,6 +887,8 @@ func Provider() *schema.Provider {
 			"ibm_pi_instance_volumes":                       power.DataSourceIBMPIInstanceVolumes(),
 			"ibm_pi_instance":                               power.DataSourceIBMPIInstance(),
 			"ibm_pi_instances":                              power.DataSourceIBMPIInstances(),
+			"ibm_pi_job":                                    power.DataSourceIBMPIJob(),
+			"ibm_pi_jobs":                                   power.DataSourceIBMPIJobs(),
 			"ibm_pi_key":                                    power.DataSourceIBMPIKey(),
 			"ibm_pi_keys":                                   power.DataSourceIBMPIKeys(),
 			"ibm_pi_network_address_group":                  power.DataSourceIBMPINetworkAddressGroup(),
@@ -840,6 +1014,9 @@ func Provider() *schema.Provider {
 			"ibm_sm_username_password_secret":                                    secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmUsernamePasswordSecret()),
 			"ibm_sm_service_credentials_secret":                                  secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmServiceCredentialsSecret()),
 			"ibm_sm_en_registration":                                             secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmEnRegistration()),
+			"ibm_sm_secret_version":                                              secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretVersion()),
+			"ibm_sm_secret_versions":                                             secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretVersions()),
+			"ibm_sm_secret_version_locks":                                        secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretVersionLocks()),
 
 			// Added for Satellite
 			"ibm_satellite_location":                            satellite.DataSourceIBMSatelliteLocation(),
@@ -1031,6 +1208,9 @@ func Provider() *schema.Provider {
 			"ibm_project_config":      project.DataSourceIbmProjectConfig(),
 			"ibm_project_environment": project.DataSourceIbmProjectEnvironment(),
 
+			// Endpoints of the provider
+			"ibm_provider_endpoints": dataSourceIBMProviderEndpoints(),
+
 			// Added for VMware as a Service
 			"ibm_vmaas_vdc": vmware.DataSourceIbmVmaasVdc(),
 			// Logs Service
@@ -1128,6 +1308,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_certificate_upload":              cis.ResourceIBMCISCertificateUpload(),
 			"ibm_cis_dns_record":                      cis.ResourceIBMCISDnsRecord(),
 			"ibm_cis_dns_records_import":              cis.ResourceIBMCISDNSRecordsImport(),
+			"ibm_cis_dns_zone_file":                   cis.ResourceIBMCISDNSZoneFile(),
 			"ibm_cis_rate_limit":                      cis.ResourceIBMCISRateLimit(),
 			"ibm_cis_page_rule":                       cis.ResourceIBMCISPageRule(),
 			"ibm_cis_edge_functions_action":           cis.ResourceIBMCISEdgeFunctionsAction(),
@@ -1200,6 +1381,7 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket":                                cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":               cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                         cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_objects_sync":                   cos.ResourceIBMCOSBucketObjectsSync(),
 			"ibm_cos_bucket_object_lock_configuration":      cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":          cos.ResourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_cos_bucket_lifecycle_configuration":        cos.ResourceIBMCOSBucketLifecycleConfiguration(),
@@ -1287,6 +1469,7 @@ func Provider() *schema.Provider {
 			"ibm_is_instance_network_interface":                  vpc.ResourceIBMIsInstanceNetworkInterface(),
 			"ibm_is_instance_network_interface_floating_ip":      vpc.ResourceIBMIsInstanceNetworkInterfaceFloatingIp(),
 			"ibm_is_instance_disk_management":                    vpc.ResourceIBMISInstanceDiskManagement(),
+			"ibm_is_instance_fleet":                              vpc.ResourceIBMISInstanceFleet(),
 			"ibm_is_instance_group":                              vpc.ResourceIBMISInstanceGroup(),
 			"ibm_is_instance_group_membership":                   vpc.ResourceIBMISInstanceGroupMembership(),
 			"ibm_is_instance_group_manager":                      vpc.ResourceIBMISInstanceGroupManager(),
@@ -1314,6 +1497,7 @@ func Provider() *schema.Provider {
 			"ibm_is_private_path_service_gateway_operations":                          vpc.ResourceIBMIsPrivatePathServiceGatewayOperations(),
 			"ibm_is_security_group":                        vpc.ResourceIBMISSecurityGroup(),
 			"ibm_is_security_group_rule":                   vpc.ResourceIBMISSecurityGroupRule(),
+			"ibm_is_security_group_rules":                  vpc.ResourceIBMISSecurityGroupRules(),
 			"ibm_is_security_group_target":                 vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_share":                                 vpc.ResourceIbmIsShare(),
 			"ibm_is_share_replica_operations":              vpc.ResourceIbmIsShareReplicaOperations(),
@@ -1532,6 +1716,9 @@ func Provider() *schema.Provider {
 			"ibm_sm_en_registration":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmEnRegistration()),
 			"ibm_sm_private_certificate_configuration_action_sign_csr":           secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSignCsr()),
 			"ibm_sm_private_certificate_configuration_action_set_signed":         secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSetSigned()),
+			"ibm_sm_secret_version":                                              secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretVersion()),
+			"ibm_sm_secret_version_locks":                                        secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretVersionLocks()),
+			"ibm_sm_secret_rotation":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretRotation()),
 
 			// satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
@@ -1740,6 +1927,7 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 }
 
 func wrapResource(name string, resource *schema.Resource) *schema.Resource {
+	resource = withDefaultTags(resource)
 	return &schema.Resource{
 		Schema:               resource.Schema,
 		SchemaVersion:        resource.SchemaVersion,
@@ -1747,11 +1935,11 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 		StateUpgraders:       resource.StateUpgraders,
 		Exists:               resource.Exists,
 		CreateContext:        wrapFunction(name, "create", resource.CreateContext, resource.Create, false),
-		ReadContext:          wrapFunction(name, "read", resource.ReadContext, resource.Read, false),
+		ReadContext:          wrapDriftReport(name, resource.Schema, wrapFunction(name, "read", resource.ReadContext, resource.Read, false)),
 		UpdateContext:        wrapFunction(name, "update", resource.UpdateContext, resource.Update, false),
 		DeleteContext:        wrapFunction(name, "delete", resource.DeleteContext, resource.Delete, false),
 		CreateWithoutTimeout: wrapFunction(name, "create", resource.CreateWithoutTimeout, nil, false),
-		ReadWithoutTimeout:   wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, false),
+		ReadWithoutTimeout:   wrapDriftReport(name, resource.Schema, wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, false)),
 		UpdateWithoutTimeout: wrapFunction(name, "update", resource.UpdateWithoutTimeout, nil, false),
 		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
 		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
@@ -1788,34 +1976,100 @@ func wrapFunction(
 ) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
 	if function != nil {
 		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-
-			// only allow deletion if the resource is not marked as protected
-			if operationName == "delete" && schema.Get("deletion_protection") != nil {
-				// we check the value in state, not current config. Current config will always be null for a delete
-
-				if schema.Get("deletion_protection") == true {
-					log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
-					var diags diag.Diagnostics
-					summary := fmt.Sprintf("Deletion protection is enabled for resource %s to prevent accidential deletion", schema.Get("name"))
-					return append(
-						diags,
-						diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  summary,
-							Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
-						},
-					)
+			if operationName == "delete" {
+				if diags := checkDeletionProtection(resourceName, schema, meta); diags != nil {
+					return diags
 				}
 			}
 
-			return function(context, schema, meta)
+			context, endTrace := conns.StartTraceOperation(context, resourceName, operationName, isDataSource)
+			diags := function(context, schema, meta)
+			endTrace(schema.Id())
+			return diags
 		}
 	} else if fallback != nil {
 		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
+			if operationName == "delete" {
+				if diags := checkDeletionProtection(resourceName, schema, meta); diags != nil {
+					return diags
+				}
+			}
+
+			_, endTrace := conns.StartTraceOperation(context, resourceName, operationName, isDataSource)
+			err := fallback(schema, meta)
+			endTrace(schema.Id())
+			return wrapError(err, resourceName, operationName, isDataSource)
+		}
+	}
+
+	return nil
+}
+
+// wrapDriftReport reports the arguments of a resource which drifted from its
+// prior state when it is read, to the drift report of the provider if any.
+func wrapDriftReport(
+	resourceName string,
+	resourceSchema map[string]*schema.Schema,
+	read func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if read == nil {
+		return nil
+	}
+	return func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		endDriftCheck := conns.StartDriftCheck(resourceName, resourceSchema, d)
+		diags := read(context, d, meta)
+		if !diags.HasError() {
+			endDriftCheck(d)
 		}
+		return diags
+	}
+}
+
+// checkDeletionProtection returns an error if the deletion of the resource is
+// prevented by its deletion_protection attribute or by a deletion_protection
+// rule of the provider.
+func checkDeletionProtection(resourceName string, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only allow deletion if the resource is not marked as protected
+	// we check the value in state, not current config. Current config will always be null for a delete
+	if d.Get("deletion_protection") == true {
+		log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
+		var diags diag.Diagnostics
+		summary := fmt.Sprintf("Deletion protection is enabled for resource %s to prevent accidential deletion", d.Get("name"))
+		return append(
+			diags,
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
+			},
+		)
 	}
 
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	var tags []string
+	switch v := d.Get("tags").(type) {
+	case *schema.Set:
+		tags = flex.ExpandStringList(v.List())
+	case []interface{}:
+		tags = flex.ExpandStringList(v)
+	}
+	for _, rule := range session.DeletionProtection() {
+		if rule.Protects(resourceName, tags) {
+			log.Printf("[DEBUG] Deletion of %s %s is prevented by the deletion_protection rule %s", resourceName, d.Id(), rule.Name)
+			var diags diag.Diagnostics
+			return append(
+				diags,
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Deletion of %s %s is prevented by the deletion_protection rule %q of the provider", resourceName, d.Id(), rule.Name),
+					Detail:   "Remove the resource from the deletion_protection rule of the provider configuration, apply and then destroy if deletion should proceed",
+				},
+			)
+		}
+	}
 	return nil
 }
 
@@ -1921,6 +2175,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_alert":                                cis.ResourceIBMCISAlertValidator(),
 				"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecordValidator(),
 				"ibm_cis_dns_records_import":                   cis.ResourceIBMCISDnsRecordsImportValidator(),
+				"ibm_cis_dns_zone_file":                        cis.ResourceIBMCISDNSZoneFileValidator(),
 				"ibm_cis_edge_functions_action":                cis.ResourceIBMCISEdgeFunctionsActionValidator(),
 				"ibm_cis_edge_functions_trigger":               cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
 				"ibm_cis_global_load_balancer":                 cis.ResourceIBMCISGlbValidator(),
@@ -1998,6 +2253,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_dedicated_host":                              vpc.ResourceIbmIsDedicatedHostValidator(),
 				"ibm_is_dedicated_host_disk_management":              vpc.ResourceIBMISDedicatedHostDiskManagementValidator(),
 				"ibm_is_flow_log":                                    vpc.ResourceIBMISFlowLogValidator(),
+				"ibm_is_instance_fleet":                              vpc.ResourceIBMISInstanceFleetValidator(),
 				"ibm_is_instance_group":                              vpc.ResourceIBMISInstanceGroupValidator(),
 				"ibm_is_instance_group_membership":                   vpc.ResourceIBMISInstanceGroupMembershipValidator(),
 				"ibm_is_instance_group_manager":                      vpc.ResourceIBMISInstanceGroupManagerValidator(),
@@ -2029,6 +2285,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_placement_group":                             vpc.ResourceIbmIsPlacementGroupValidator(),
 				"ibm_is_security_group_target":                       vpc.ResourceIBMISSecurityGroupTargetValidator(),
 				"ibm_is_security_group_rule":                         vpc.ResourceIBMISSecurityGroupRuleValidator(),
+				"ibm_is_security_group_rules":                        vpc.ResourceIBMISSecurityGroupRulesValidator(),
 				"ibm_is_security_group":                              vpc.ResourceIBMISSecurityGroupValidator(),
 				"ibm_is_share":                                       vpc.ResourceIbmIsShareValidator(),
 				"ibm_is_share_replica_operations":                    vpc.ResourceIbmIsShareReplicaOperationsValidator(),
@@ -2239,6 +2496,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_custom_certificates":         cis.DataSourceIBMCISCustomCertificatesValidator(),
 				"ibm_cis_custom_pages":                cis.DataSourceIBMCISCustomPagesValidator(),
 				"ibm_cis_dns_records":                 cis.DataSourceIBMCISDNSRecordsValidator(),
+				"ibm_cis_dns_zone_file":               cis.DataSourceIBMCISDNSZoneFileValidator(),
 				"ibm_cis_domain":                      cis.DataSourceIBMCISDomainValidator(),
 				"ibm_cis_certificates":                cis.DataSourceIBMCISCertificatesValidator(),
 				"ibm_cis_edge_functions_actions":      cis.DataSourceIBMCISEdgeFunctionsActionsValidator(),
@@ -2327,6 +2585,29 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if ttoken, ok := d.GetOk("iam_profile_id"); ok {
 		iamTrustedProfileId = ttoken.(string)
 	}
+	var iamTrustedProfileName, authType, crTokenFile, cliConfigFile string
+	if name, ok := d.GetOk("iam_profile_name"); ok {
+		iamTrustedProfileName = name.(string)
+	}
+	if t, ok := d.GetOk("auth_type"); ok {
+		authType = t.(string)
+	}
+	if f, ok := d.GetOk("cr_token_file"); ok {
+		crTokenFile = f.(string)
+	}
+	if f, ok := d.GetOk("cli_config_file"); ok {
+		cliConfigFile = f.(string)
+	}
+	var assumeTrustedProfile *conns.AssumeTrustedProfile
+	if p, ok := d.GetOk("assume_trusted_profile"); ok && p.([]interface{})[0] != nil {
+		profile := p.([]interface{})[0].(map[string]interface{})
+		assumeTrustedProfile = &conns.AssumeTrustedProfile{
+			ProfileID:   profile["profile_id"].(string),
+			ProfileCRN:  profile["profile_crn"].(string),
+			ProfileName: profile["profile_name"].(string),
+			AccountID:   profile["account_id"].(string),
+		}
+	}
 	var softlayerUsername, softlayerAPIKey, softlayerEndpointUrl string
 	var softlayerTimeout int
 	if username, ok := d.GetOk("softlayer_username"); ok {
@@ -2368,11 +2649,72 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	var traceFile string
+	if f, ok := d.GetOk("http_trace_file"); ok {
+		traceFile = f.(string)
+	}
+	var driftReportFile string
+	if f, ok := d.GetOk("drift_report_file"); ok {
+		driftReportFile = f.(string)
+	}
+	var deletionProtection []conns.DeletionProtectionRule
+	for i, r := range d.Get("deletion_protection").([]interface{}) {
+		rule := conns.DeletionProtectionRule{
+			Name: fmt.Sprintf("deletion_protection.%d", i),
+		}
+		if r != nil {
+			rawRule := r.(map[string]interface{})
+			if name := rawRule["name"].(string); name != "" {
+				rule.Name = name
+			}
+			rule.ResourceTypes = flex.ExpandStringList(rawRule["resource_types"].(*schema.Set).List())
+			rule.Tags = flex.ExpandStringList(rawRule["tags"].(*schema.Set).List())
+		}
+		if len(rule.ResourceTypes) == 0 && len(rule.Tags) == 0 {
+			return nil, fmt.Errorf("[ERROR] The deletion_protection rule %s must set resource_types, tags or both", rule.Name)
+		}
+		deletionProtection = append(deletionProtection, rule)
+	}
+	var pollIntervals []conns.PollIntervalRule
+	for i, r := range d.Get("poll_interval").([]interface{}) {
+		if r == nil {
+			continue
+		}
+		rawRule := r.(map[string]interface{})
+		rule := conns.PollIntervalRule{
+			ResourceTypes: flex.ExpandStringList(rawRule["resource_types"].(*schema.Set).List()),
+		}
+		min, err := time.ParseDuration(rawRule["min"].(string))
+		if err != nil || min <= 0 {
+			return nil, fmt.Errorf("[ERROR] The min of poll_interval.%d must be a positive duration like 5s: %q", i, rawRule["min"])
+		}
+		rule.Interval = waiter.Interval{Min: min, Max: min}
+		if max := rawRule["max"].(string); max != "" {
+			if rule.Interval.Max, err = time.ParseDuration(max); err != nil || rule.Interval.Max < min {
+				return nil, fmt.Errorf("[ERROR] The max of poll_interval.%d must be a duration like 1m, at least min: %q", i, max)
+			}
+		}
+		pollIntervals = append(pollIntervals, rule)
+	}
+	var defaultTags, defaultAccessTags []string
+	if v, ok := d.GetOk("default_tags"); ok && v.([]interface{})[0] != nil {
+		tags := v.([]interface{})[0].(map[string]interface{})
+		defaultTags = flex.ExpandStringList(tags["tags"].(*schema.Set).List())
+		defaultAccessTags = flex.ExpandStringList(tags["access_tags"].(*schema.Set).List())
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	rateLimits := map[string]conns.RateLimit{}
+	for _, l := range d.Get("rate_limit").([]interface{}) {
+		limit := l.(map[string]interface{})
+		rateLimits[limit["service"].(string)] = conns.RateLimit{
+			RequestsPerSecond: limit["requests_per_second"].(float64),
+			Burst:             limit["burst"].(int),
+		}
+	}
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -2386,24 +2728,36 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	config := conns.Config{
-		BluemixAPIKey:        bluemixAPIKey,
-		Region:               region,
-		ResourceGroup:        resourceGrp,
-		BluemixTimeout:       time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:    softlayerUsername,
-		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
-		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
-		FunctionNameSpace:    wskNameSpace,
-		RiaasEndPoint:        riaasEndPoint,
-		IAMToken:             iamToken,
-		IAMRefreshToken:      iamRefreshToken,
-		Zone:                 zone,
-		Visibility:           visibility,
-		EndpointsFile:        file,
-		IAMTrustedProfileID:  iamTrustedProfileId,
+		BluemixAPIKey:         bluemixAPIKey,
+		Region:                region,
+		ResourceGroup:         resourceGrp,
+		BluemixTimeout:        time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:      time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:     softlayerUsername,
+		SoftLayerAPIKey:       softlayerAPIKey,
+		RetryCount:            retryCount,
+		SoftLayerEndpointURL:  softlayerEndpointUrl,
+		RetryDelay:            conns.RetryAPIDelay,
+		FunctionNameSpace:     wskNameSpace,
+		RiaasEndPoint:         riaasEndPoint,
+		IAMToken:              iamToken,
+		IAMRefreshToken:       iamRefreshToken,
+		Zone:                  zone,
+		Visibility:            visibility,
+		EndpointsFile:         file,
+		IAMTrustedProfileID:   iamTrustedProfileId,
+		IAMTrustedProfileName: iamTrustedProfileName,
+		AuthType:              authType,
+		CRTokenFile:           crTokenFile,
+		CLIConfigFile:         cliConfigFile,
+		AssumeTrustedProfile:  assumeTrustedProfile,
+		RateLimits:            rateLimits,
+		TraceFile:             traceFile,
+		DriftReportFile:       driftReportFile,
+		DeletionProtection:    deletionProtection,
+		DefaultTags:           defaultTags,
+		DefaultAccessTags:     defaultAccessTags,
+		PollIntervals:         pollIntervals,
 	}
 
 	return config.ClientSession()
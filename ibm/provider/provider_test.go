@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+)
+
+func TestProviderDeletionProtection(t *testing.T) {
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "GET", Path: "/v1/vpcs/r006-dev", State: "deleted", Status: 404, Body: []byte(`{"errors":[{"code":"not_found","message":"VPC not found"}]}`)},
+		unittest.Fixture{Method: "GET", Path: "/v1/vpcs/r006-dev", Body: []byte(`{"id":"r006-dev","name":"test","status":"available"}`)},
+		unittest.Fixture{Method: "DELETE", Path: "/v1/vpcs/r006-dev", Status: 204, NextState: "deleted"},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "/v1", "IBMCLOUD_GS_API_ENDPOINT": ""})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"deletion_protection": []interface{}{
+			map[string]interface{}{
+				"name": "production",
+				"tags": []interface{}{"env:prod*"},
+			},
+			map[string]interface{}{
+				"resource_types": []interface{}{"ibm_is_volume", "ibm_database"},
+			},
+		},
+	}))
+	if diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+
+	for _, tc := range []struct {
+		resource string
+		id       string
+		tags     []interface{}
+		rule     string
+	}{
+		{"ibm_is_vpc", "r006-prod", []interface{}{"env:production"}, `"production"`},
+		{"ibm_is_volume", "r006-volume", nil, `"deletion_protection.1"`},
+		{"ibm_is_vpc", "r006-dev", []interface{}{"env:dev"}, ""},
+	} {
+		resource := p.ResourcesMap[tc.resource]
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"name": "test", "tags": tc.tags})
+		d.SetId(tc.id)
+		diags := resource.DeleteContext(context.Background(), d, p.Meta())
+		if tc.rule == "" {
+			if diags.HasError() {
+				t.Errorf("Deletion of %s %s failed: %v", tc.resource, tc.id, diags)
+			}
+			continue
+		}
+		if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.rule) {
+			t.Errorf("Deletion of %s %s was not prevented by the rule %s: %v", tc.resource, tc.id, tc.rule, diags)
+		}
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProviderDeletionProtectionInvalid(t *testing.T) {
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"deletion_protection": []interface{}{
+			map[string]interface{}{"name": "empty"},
+		},
+	}))
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "empty") {
+		t.Fatalf("Provider configured with an empty deletion_protection rule: %v", diags)
+	}
+}
+
+func TestProviderDefaultTags(t *testing.T) {
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "GET", Path: "/v3/tags", Body: []byte(`{"total_count":0,"items":[]}`)},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_GT_API_ENDPOINT": ""})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"default_tags": []interface{}{
+			map[string]interface{}{
+				"tags": []interface{}{"cost-center:42", "owner:finops"},
+			},
+		},
+	}))
+	if diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+
+	resource := p.ResourcesMap["ibm_is_vpc"]
+	if s := resource.Schema["tags_all"]; s == nil || !s.Computed {
+		t.Fatalf("ibm_is_vpc has no computed tags_all: %v", s)
+	}
+	if s := resource.Schema["access_tags_all"]; s == nil {
+		t.Fatal("ibm_is_vpc has no access_tags_all")
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "test",
+		"tags": []interface{}{"env:dev", "cost-center:42", "owner:finops"},
+	})
+	d.SetId("r006-test")
+	state := d.State()
+	for _, tc := range []struct {
+		tags    []interface{}
+		changed bool
+	}{
+		{[]interface{}{"env:dev"}, false},
+		{[]interface{}{"env:dev", "cost-center:42", "owner:finops"}, false},
+		{[]interface{}{"env:prod"}, true},
+	} {
+		diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "test",
+			"tags": tc.tags,
+		}), p.Meta())
+		if err != nil {
+			t.Fatalf("Diff with tags %v returned an error: %s", tc.tags, err)
+		}
+		changed := false
+		for k := range diff.Attributes {
+			if strings.HasPrefix(k, "tags.") {
+				changed = true
+			}
+		}
+		if changed != tc.changed {
+			t.Errorf("Diff with tags %v changes the tags: %v, expected %v", tc.tags, changed, tc.changed)
+		}
+		// tags_all is planned as the tags with the default tags, the tags
+		// of the state when only default tags are removed.
+		if all := diff.Attributes["tags_all.#"]; all == nil || all.New != "3" {
+			t.Errorf("Diff with tags %v plans tags_all %v, expected 3 tags", tc.tags, all)
+		}
+	}
+
+	// The tags of ibm_resource_group are not computed, so the default tags
+	// are kept out of them when read rather than suppressed in the diff.
+	resource = p.ResourcesMap["ibm_resource_group"]
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "test",
+		"tags": []interface{}{"env:dev", "cost-center:42"},
+	})
+	d.SetId("test-group")
+	diff, err := resource.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "test",
+		"tags": []interface{}{"env:dev"},
+	}), p.Meta())
+	if err != nil {
+		t.Fatalf("Diff of ibm_resource_group returned an error: %s", err)
+	}
+	if all := diff.Attributes["tags_all.#"]; all == nil || all.New != "3" {
+		t.Errorf("Diff of ibm_resource_group plans tags_all %v, expected 3 tags", all)
+	}
+}
+
+func TestProviderEndpoints(t *testing.T) {
+	m := unittest.NewMockServer(t)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "/v1"})
+	unittest.UseMockCredentials(t)
+	t.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "")
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	dataSource := p.DataSourcesMap["ibm_provider_endpoints"]
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"services": []interface{}{"IBMCLOUD_IS_NG_API_ENDPOINT"},
+	})
+	if diags := dataSource.ReadContext(context.Background(), d, p.Meta()); diags.HasError() {
+		t.Fatalf("Read of ibm_provider_endpoints failed: %v", diags)
+	}
+	if url, source := d.Get("endpoints.0.url"), d.Get("endpoints.0.source"); url != m.URL+"/v1" || source != "endpoints_file" {
+		t.Errorf("ibm_provider_endpoints reported %v from %v", url, source)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSZoneFileRecordCount = "record_count"
+)
+
+func DataSourceIBMCISDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCISDNSZoneFileRead,
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "DNS Zone CRN",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_dns_zone_file",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Zone Id",
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisZoneName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "zone name",
+			},
+			cisDNSZoneFileContent: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "BIND zone file content of the domain",
+			},
+			cisDNSZoneFileRecordCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of records in the zone file content",
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISDNSZoneFileValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	iBMCISDNSZoneFileValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_zone_file",
+		Schema:       validateSchema}
+	return &iBMCISDNSZoneFileValidator
+}
+
+func dataSourceIBMCISDNSZoneFileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	zoneName, _, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	records, unsupported, err := listCISDNSZoneRecords(meta, crn, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisZoneName, zoneName)
+	d.Set(cisDNSZoneFileContent, renderZoneFile(zoneName, records, unsupported))
+	d.Set(cisDNSZoneFileRecordCount, len(records))
+	return nil
+}
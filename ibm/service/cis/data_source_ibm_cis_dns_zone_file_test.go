@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSZoneFileDataSource_basic(t *testing.T) {
+	node := "data.ibm_cis_dns_zone_file.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSZoneFileDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "zone_name", acc.CisDomainStatic),
+					resource.TestCheckResourceAttrSet(node, "record_count"),
+					resource.TestMatchResourceAttr(node, "content",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^test\.%s\.\t1\tIN\tA\t192\.168\.0\.10$`, regexp.QuoteMeta(acc.CisDomainStatic)))),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSZoneFileDataSourceConfig() string {
+	return testAccCheckIBMCisDNSRecordConfigCisDSBasic("test", acc.CisDomainStatic) + `
+	data "ibm_cis_dns_zone_file" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = ibm_cis_dns_record.test.domain_id
+	}`
+}
@@ -0,0 +1,623 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+)
+
+// zoneTXTChunkSize is the longest character string of a TXT record.
+const zoneTXTChunkSize = 255
+
+// zoneRecord is a DNS record in its zone file form. Names are fully qualified,
+// lower case and without the trailing dot.
+type zoneRecord struct {
+	ID       string
+	Name     string
+	Type     string
+	TTL      int64
+	Content  string
+	Priority int64
+	Weight   int64
+	Port     int64
+	Flags    int64
+	Tag      string
+	Proxied  bool
+}
+
+// rdata returns the zone file rdata of the record.
+func (r zoneRecord) rdata() string {
+	switch r.Type {
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		return r.Content + "."
+	case cisDNSRecordTypeMX:
+		return fmt.Sprintf("%d %s.", r.Priority, r.Content)
+	case cisDNSRecordTypeSRV:
+		return fmt.Sprintf("%d %d %d %s.", r.Priority, r.Weight, r.Port, r.Content)
+	case cisDNSRecordTypeCAA:
+		return fmt.Sprintf("%d %s %s", r.Flags, r.Tag, quoteZoneString(r.Content))
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+		chunks := []string{}
+		content := r.Content
+		for len(content) > zoneTXTChunkSize {
+			chunks = append(chunks, quoteZoneString(content[:zoneTXTChunkSize]))
+			content = content[zoneTXTChunkSize:]
+		}
+		chunks = append(chunks, quoteZoneString(content))
+		return strings.Join(chunks, " ")
+	}
+	return r.Content
+}
+
+// key identifies the record regardless of its TTL.
+func (r zoneRecord) key() string {
+	return fmt.Sprintf("%s %s %s", r.Name, r.Type, r.rdata())
+}
+
+// String returns the record as a zone file line.
+func (r zoneRecord) String() string {
+	return fmt.Sprintf("%s.\t%d\tIN\t%s\t%s", r.Name, r.TTL, r.Type, r.rdata())
+}
+
+func quoteZoneString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// sortZoneRecords sorts the records by name, type and rdata.
+func sortZoneRecords(records []zoneRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		if records[i].Type != records[j].Type {
+			return records[i].Type < records[j].Type
+		}
+		return records[i].rdata() < records[j].rdata()
+	})
+}
+
+// zoneRecordFilter selects the records which are left alone by the zone file
+// sync, like the proxied records or the records of an ACME client.
+type zoneRecordFilter struct {
+	proxied bool
+	types   map[string]bool
+	names   []*regexp.Regexp
+}
+
+func (f zoneRecordFilter) ignores(r zoneRecord) bool {
+	if f.proxied && r.Proxied {
+		return true
+	}
+	if f.types[r.Type] {
+		return true
+	}
+	for _, name := range f.names {
+		if name.MatchString(r.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f zoneRecordFilter) filter(records []zoneRecord) []zoneRecord {
+	filtered := []zoneRecord{}
+	for _, r := range records {
+		if !f.ignores(r) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// zoneRecordFromCIS converts a CIS DNS record, it returns false for the record
+// types which have no zone file form.
+func zoneRecordFromCIS(record dnsrecordsv1.DnsrecordDetails) (zoneRecord, bool) {
+	r := zoneRecord{}
+	if record.ID != nil {
+		r.ID = *record.ID
+	}
+	if record.Name != nil {
+		r.Name = canonicalZoneName(*record.Name)
+	}
+	if record.Type != nil {
+		r.Type = strings.ToUpper(*record.Type)
+	}
+	if record.TTL != nil {
+		r.TTL = *record.TTL
+	}
+	if record.Priority != nil {
+		r.Priority = *record.Priority
+	}
+	if record.Proxied != nil {
+		r.Proxied = *record.Proxied
+	}
+	if record.Content != nil {
+		r.Content = *record.Content
+	}
+	data, _ := record.Data.(map[string]interface{})
+
+	switch r.Type {
+	case cisDNSRecordTypeA, cisDNSRecordTypeAAAA:
+		if ip := net.ParseIP(r.Content); ip != nil {
+			r.Content = ip.String()
+		}
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR, cisDNSRecordTypeMX:
+		r.Content = canonicalZoneName(r.Content)
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+	case cisDNSRecordTypeSRV:
+		if data == nil {
+			return r, false
+		}
+		r.Priority = zoneRecordDataInt(data, "priority")
+		r.Weight = zoneRecordDataInt(data, "weight")
+		r.Port = zoneRecordDataInt(data, "port")
+		r.Content = canonicalZoneName(fmt.Sprintf("%v", data["target"]))
+	case cisDNSRecordTypeCAA:
+		if data == nil {
+			return r, false
+		}
+		r.Flags = zoneRecordDataInt(data, "flags")
+		r.Tag = fmt.Sprintf("%v", data["tag"])
+		r.Content = fmt.Sprintf("%v", data["value"])
+	default:
+		return r, false
+	}
+	return r, true
+}
+
+func zoneRecordDataInt(data map[string]interface{}, key string) int64 {
+	switch v := data[key].(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case string:
+		i, _ := strconv.ParseInt(v, 10, 64)
+		return i
+	}
+	return 0
+}
+
+// cisData returns the data of the SRV and CAA records as expected by the CIS
+// DNS records API.
+func (r zoneRecord) cisData() map[string]interface{} {
+	switch r.Type {
+	case cisDNSRecordTypeSRV:
+		labels := strings.SplitN(r.Name, ".", 3)
+		return map[string]interface{}{
+			"service":  labels[0],
+			"proto":    labels[1],
+			"name":     labels[2],
+			"priority": r.Priority,
+			"weight":   r.Weight,
+			"port":     r.Port,
+			"target":   r.Content,
+		}
+	case cisDNSRecordTypeCAA:
+		return map[string]interface{}{
+			"flags": r.Flags,
+			"tag":   r.Tag,
+			"value": r.Content,
+		}
+	}
+	return nil
+}
+
+// diffZoneRecords pairs the records of the zone file with the live records of
+// the zone. Records with the same name, type and rdata are kept, and only
+// updated when their TTL differs. The remaining records of the same name and
+// type are updated in place, the others are created or deleted. The updated
+// records carry the ID of the live record they replace.
+func diffZoneRecords(desired, live []zoneRecord) (create, update, remove []zoneRecord) {
+	groups := map[string][2][]zoneRecord{}
+	groupKeys := []string{}
+	add := func(r zoneRecord, i int) {
+		k := r.Name + " " + r.Type
+		g, ok := groups[k]
+		if !ok {
+			groupKeys = append(groupKeys, k)
+		}
+		g[i] = append(g[i], r)
+		groups[k] = g
+	}
+	sortZoneRecords(desired)
+	sortZoneRecords(live)
+	for _, r := range desired {
+		add(r, 0)
+	}
+	for _, r := range live {
+		add(r, 1)
+	}
+	sort.Strings(groupKeys)
+
+	for _, k := range groupKeys {
+		wanted, current := groups[k][0], groups[k][1]
+		unmatched := []zoneRecord{}
+		for _, w := range wanted {
+			matched := false
+			for i, c := range current {
+				if c.key() == w.key() {
+					if c.TTL != w.TTL {
+						w.ID = c.ID
+						update = append(update, w)
+					}
+					current = append(current[:i:i], current[i+1:]...)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				unmatched = append(unmatched, w)
+			}
+		}
+		for i, w := range unmatched {
+			if i < len(current) {
+				w.ID = current[i].ID
+				update = append(update, w)
+			} else {
+				create = append(create, w)
+			}
+		}
+		if len(current) > len(unmatched) {
+			remove = append(remove, current[len(unmatched):]...)
+		}
+	}
+	return
+}
+
+// renderZoneFile renders the records of a zone as a BIND zone file.
+func renderZoneFile(zoneName string, records []zoneRecord, unsupported []dnsrecordsv1.DnsrecordDetails) string {
+	sortZoneRecords(records)
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", canonicalZoneName(zoneName))
+	for _, r := range records {
+		b.WriteString(r.String())
+		if r.Proxied {
+			b.WriteString(" ; proxied")
+		}
+		b.WriteString("\n")
+	}
+	for _, record := range unsupported {
+		name, recordType := "", ""
+		if record.Name != nil {
+			name = *record.Name
+		}
+		if record.Type != nil {
+			recordType = *record.Type
+		}
+		fmt.Fprintf(&b, "; %s record %s is not exported\n", recordType, name)
+	}
+	return b.String()
+}
+
+// canonicalZoneName returns a fully qualified name in lower case and without
+// the trailing dot.
+func canonicalZoneName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+// absoluteZoneName resolves a zone file name against the current origin.
+func absoluteZoneName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return canonicalZoneName(name)
+	case origin == "":
+		return canonicalZoneName(name)
+	}
+	return canonicalZoneName(name + "." + origin)
+}
+
+func inZone(name, zoneName string) bool {
+	return name == zoneName || strings.HasSuffix(name, "."+zoneName)
+}
+
+// zoneFileToken is a word or a quoted character string of a zone file.
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneFileEntry is a directive or a resource record of a zone file, which may
+// span several lines within parentheses.
+type zoneFileEntry struct {
+	line       int
+	blankOwner bool
+	tokens     []zoneFileToken
+}
+
+// tokenizeZoneFile splits a zone file in entries, as described by RFC 1035
+// section 5.1.
+func tokenizeZoneFile(content string) ([]zoneFileEntry, error) {
+	var (
+		entries     []zoneFileEntry
+		entry       zoneFileEntry
+		token       strings.Builder
+		inToken     bool
+		quoted      bool
+		inQuote     bool
+		parens      int
+		line        = 1
+		startOfLine = true
+	)
+	flush := func() {
+		if inToken {
+			entry.tokens = append(entry.tokens, zoneFileToken{text: token.String(), quoted: quoted})
+		}
+		token.Reset()
+		inToken, quoted = false, false
+	}
+	escape := func(b string, i int) int {
+		if i+3 < len(b) && isDigit(b[i+1]) && isDigit(b[i+2]) && isDigit(b[i+3]) {
+			v, _ := strconv.Atoi(b[i+1 : i+4])
+			token.WriteByte(byte(v))
+			return i + 3
+		}
+		if i+1 < len(b) {
+			token.WriteByte(b[i+1])
+		}
+		return i + 1
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if startOfLine {
+			entry = zoneFileEntry{line: line, blankOwner: c == ' ' || c == '\t'}
+			startOfLine = false
+		}
+		switch {
+		case inQuote:
+			switch c {
+			case '"':
+				inQuote = false
+			case '\\':
+				i = escape(content, i)
+			case '\n':
+				return nil, fmt.Errorf("[ERROR] Unterminated character string at line %d", line)
+			default:
+				token.WriteByte(c)
+			}
+		case c == '\\':
+			inToken = true
+			i = escape(content, i)
+		case c == '"':
+			inToken, quoted, inQuote = true, true, true
+		case c == ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case c == '(':
+			flush()
+			parens++
+		case c == ')':
+			flush()
+			if parens == 0 {
+				return nil, fmt.Errorf("[ERROR] Unbalanced parenthesis at line %d", line)
+			}
+			parens--
+		case c == '\n':
+			flush()
+			line++
+			if parens == 0 {
+				if len(entry.tokens) > 0 {
+					entries = append(entries, entry)
+				}
+				entry = zoneFileEntry{}
+				startOfLine = true
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		default:
+			inToken = true
+			token.WriteByte(c)
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("[ERROR] Unterminated character string at line %d", line)
+	}
+	if parens > 0 {
+		return nil, fmt.Errorf("[ERROR] Unbalanced parenthesis at line %d", line)
+	}
+	flush()
+	if len(entry.tokens) > 0 {
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parseZoneTTL parses a TTL in seconds or with the BIND units, like 1h30m.
+func parseZoneTTL(s string) (int64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	if ttl, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return ttl, ttl >= 0
+	}
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var ttl, n int64
+	digits := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isDigit(c) {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || !digits {
+			return 0, false
+		}
+		ttl += n * unit
+		n, digits = 0, false
+	}
+	if digits {
+		return 0, false
+	}
+	return ttl, true
+}
+
+// parseZoneFile parses the resource records of a BIND zone file of the given
+// zone. The SOA and apex NS records are skipped, they are managed by CIS.
+// Omitted TTLs default to the $TTL directive, then to the last TTL of the
+// file, then to 1, which is the automatic TTL of CIS.
+func parseZoneFile(content, zoneName string) ([]zoneRecord, error) {
+	entries, err := tokenizeZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+	zone := canonicalZoneName(zoneName)
+	origin := zone
+	owner := ""
+	defaultTTL, lastTTL := int64(-1), int64(-1)
+	records := []zoneRecord{}
+
+	for _, e := range entries {
+		tokens := e.tokens
+		if !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			directive := strings.ToUpper(tokens[0].text)
+			switch {
+			case directive == "$ORIGIN" && len(tokens) == 2:
+				origin = absoluteZoneName(tokens[1].text, origin)
+			case directive == "$TTL" && len(tokens) == 2:
+				ttl, ok := parseZoneTTL(tokens[1].text)
+				if !ok {
+					return nil, fmt.Errorf("[ERROR] Invalid TTL %q at line %d", tokens[1].text, e.line)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("[ERROR] Unsupported directive %s at line %d", tokens[0].text, e.line)
+			}
+			continue
+		}
+
+		i := 0
+		if !e.blankOwner {
+			owner = absoluteZoneName(tokens[0].text, origin)
+			i++
+		} else if owner == "" {
+			return nil, fmt.Errorf("[ERROR] Missing owner name at line %d", e.line)
+		}
+		ttl := int64(-1)
+		for ; i < len(tokens)-1; i++ {
+			if t, ok := parseZoneTTL(tokens[i].text); ok && ttl < 0 {
+				ttl = t
+				continue
+			}
+			if strings.EqualFold(tokens[i].text, "IN") {
+				continue
+			}
+			break
+		}
+		if i >= len(tokens) {
+			return nil, fmt.Errorf("[ERROR] Missing record type at line %d", e.line)
+		}
+		switch {
+		case ttl >= 0:
+			lastTTL = ttl
+		case defaultTTL >= 0:
+			ttl = defaultTTL
+		case lastTTL >= 0:
+			ttl = lastTTL
+		default:
+			ttl = 1
+		}
+
+		recordType := strings.ToUpper(tokens[i].text)
+		if recordType == "SOA" || (recordType == cisDNSRecordTypeNS && owner == zone) {
+			continue
+		}
+		if !inZone(owner, zone) {
+			return nil, fmt.Errorf("[ERROR] Record %s at line %d is outside of the zone %s", owner, e.line, zone)
+		}
+		r, err := newZoneRecord(owner, recordType, ttl, tokens[i+1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Invalid %s record at line %d: %s", recordType, e.line, err)
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func newZoneRecord(name, recordType string, ttl int64, rdata []zoneFileToken, origin string) (zoneRecord, error) {
+	r := zoneRecord{Name: name, Type: recordType, TTL: ttl}
+	arity := map[string]int{
+		cisDNSRecordTypeA:     1,
+		cisDNSRecordTypeAAAA:  1,
+		cisDNSRecordTypeCNAME: 1,
+		cisDNSRecordTypeNS:    1,
+		cisDNSRecordTypePTR:   1,
+		cisDNSRecordTypeMX:    2,
+		cisDNSRecordTypeSRV:   4,
+		cisDNSRecordTypeCAA:   3,
+	}
+	if n, ok := arity[recordType]; ok && len(rdata) != n {
+		return r, fmt.Errorf("expected %d rdata fields, got %d", n, len(rdata))
+	}
+	number := func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 32)
+	}
+
+	var err error
+	switch recordType {
+	case cisDNSRecordTypeA, cisDNSRecordTypeAAAA:
+		ip := net.ParseIP(rdata[0].text)
+		if ip == nil || (ip.To4() != nil) != (recordType == cisDNSRecordTypeA) {
+			return r, fmt.Errorf("invalid address %q", rdata[0].text)
+		}
+		r.Content = ip.String()
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		r.Content = absoluteZoneName(rdata[0].text, origin)
+	case cisDNSRecordTypeMX:
+		if r.Priority, err = number(rdata[0].text); err != nil {
+			return r, err
+		}
+		r.Content = absoluteZoneName(rdata[1].text, origin)
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+		if len(rdata) == 0 {
+			return r, fmt.Errorf("missing character string")
+		}
+		for _, t := range rdata {
+			r.Content += t.text
+		}
+	case cisDNSRecordTypeSRV:
+		labels := strings.SplitN(name, ".", 3)
+		if len(labels) < 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return r, fmt.Errorf("the name %s is not of the form _service._proto.name", name)
+		}
+		if r.Priority, err = number(rdata[0].text); err != nil {
+			return r, err
+		}
+		if r.Weight, err = number(rdata[1].text); err != nil {
+			return r, err
+		}
+		if r.Port, err = number(rdata[2].text); err != nil {
+			return r, err
+		}
+		r.Content = absoluteZoneName(rdata[3].text, origin)
+	case cisDNSRecordTypeCAA:
+		if r.Flags, err = number(rdata[0].text); err != nil {
+			return r, err
+		}
+		r.Tag = strings.ToLower(rdata[1].text)
+		r.Content = rdata[2].text
+	default:
+		return r, fmt.Errorf("the record type is not supported")
+	}
+	return r, nil
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@       IN SOA ns1.example.net. admin.example.com. (
+                2024010101 ; serial
+                7200 3600 1209600 3600 )
+        IN NS  ns1.example.net.
+        IN MX  10 mail
+www     300 IN A 192.0.2.10
+        IN AAAA 2001:DB8::10
+mail    IN CNAME www.example.com.
+@       TXT "v=spf1 mx -all" ; spf
+long    TXT ( "part one "
+              "part \"two\"" )
+_sip._tcp SRV 10 5 5060 sip.example.net.
+@       CAA 0 issue "letsencrypt.org"
+$ORIGIN sub.example.com.
+host    1d NS ns.example.net.
+`
+
+func TestUnitParseZoneFile(t *testing.T) {
+	records, err := parseZoneFile(testZoneFile, "Example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := []string{}
+	for _, r := range records {
+		lines = append(lines, r.String())
+	}
+	expected := []string{
+		"example.com.\t3600\tIN\tMX\t10 mail.example.com.",
+		"www.example.com.\t300\tIN\tA\t192.0.2.10",
+		"www.example.com.\t3600\tIN\tAAAA\t2001:db8::10",
+		"mail.example.com.\t3600\tIN\tCNAME\twww.example.com.",
+		"example.com.\t3600\tIN\tTXT\t\"v=spf1 mx -all\"",
+		"long.example.com.\t3600\tIN\tTXT\t\"part one part \\\"two\\\"\"",
+		"_sip._tcp.example.com.\t3600\tIN\tSRV\t10 5 5060 sip.example.net.",
+		"example.com.\t3600\tIN\tCAA\t0 issue \"letsencrypt.org\"",
+		"host.sub.example.com.\t86400\tIN\tNS\tns.example.net.",
+	}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Parsed records are\n%s\nexpected\n%s", strings.Join(lines, "\n"), strings.Join(expected, "\n"))
+	}
+
+	// The parsed records render to the same records
+	rendered, err := parseZoneFile(renderZoneFile("example.com", records, nil), "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortZoneRecords(records)
+	if !reflect.DeepEqual(rendered, records) {
+		t.Errorf("Rendered records are %v, expected %v", rendered, records)
+	}
+}
+
+func TestUnitParseZoneFileErrors(t *testing.T) {
+	for content, message := range map[string]string{
+		"www A 192.0.2.1\n":                      "", // relative to the zone
+		"www.example.org. A 192.0.2.1\n":         "outside of the zone",
+		"www A 2001:db8::1\n":                    "invalid address",
+		"www HINFO cpu os\n":                     "not supported",
+		"$INCLUDE other.zone\n":                  "Unsupported directive",
+		"www TXT \"unterminated\n":               "Unterminated character string",
+		"www MX ( 10 mail\n":                     "Unbalanced parenthesis",
+		"  A 192.0.2.1\n":                        "Missing owner name",
+		"www.example.com. 1x A 192.0.2.1\n":      "not supported",
+		"_sip.example.com. SRV 1 1 1 sip.com.\n": "not of the form",
+	} {
+		_, err := parseZoneFile(content, "example.com")
+		switch {
+		case message == "" && err != nil:
+			t.Errorf("Parsing %q failed: %s", content, err)
+		case message != "" && (err == nil || !strings.Contains(err.Error(), message)):
+			t.Errorf("Parsing %q returned %v, expected %q", content, err, message)
+		}
+	}
+}
+
+func TestUnitZoneRecordFromCIS(t *testing.T) {
+	r, ok := zoneRecordFromCIS(dnsrecordsv1.DnsrecordDetails{
+		ID:   core.StringPtr("1"),
+		Name: core.StringPtr("_sip._tcp.Example.com"),
+		Type: core.StringPtr("SRV"),
+		TTL:  core.Int64Ptr(1),
+		Data: map[string]interface{}{
+			"service": "_sip", "proto": "_tcp", "name": "example.com",
+			"priority": float64(10), "weight": float64(5), "port": float64(5060), "target": "sip.example.net",
+		},
+	})
+	if !ok || r.String() != "_sip._tcp.example.com.\t1\tIN\tSRV\t10 5 5060 sip.example.net." {
+		t.Errorf("SRV record is %s", r)
+	}
+	if data := r.cisData(); data["service"] != "_sip" || data["proto"] != "_tcp" || data["name"] != "example.com" {
+		t.Errorf("SRV record data is %v", data)
+	}
+
+	if _, ok := zoneRecordFromCIS(dnsrecordsv1.DnsrecordDetails{
+		Name: core.StringPtr("loc.example.com"),
+		Type: core.StringPtr("LOC"),
+	}); ok {
+		t.Errorf("LOC record is converted")
+	}
+}
+
+func TestUnitDiffZoneRecords(t *testing.T) {
+	record := func(id, name, recordType, content string, ttl int64) zoneRecord {
+		return zoneRecord{ID: id, Name: name, Type: recordType, Content: content, TTL: ttl}
+	}
+	desired := []zoneRecord{
+		record("", "a.example.com", "A", "192.0.2.1", 300),
+		record("", "a.example.com", "A", "192.0.2.2", 300),
+		record("", "b.example.com", "A", "192.0.2.3", 600),
+		record("", "c.example.com", "CNAME", "a.example.com", 1),
+		record("", "d.example.com", "TXT", "new", 1),
+	}
+	live := []zoneRecord{
+		record("1", "a.example.com", "A", "192.0.2.1", 300),
+		record("2", "a.example.com", "A", "192.0.2.9", 300),
+		record("3", "b.example.com", "A", "192.0.2.3", 300),
+		record("4", "c.example.com", "A", "192.0.2.4", 1),
+		record("5", "e.example.com", "TXT", "old", 1),
+	}
+	create, update, remove := diffZoneRecords(desired, live)
+
+	ids := func(records []zoneRecord) []string {
+		keys := []string{}
+		for _, r := range records {
+			keys = append(keys, r.ID+" "+r.key())
+		}
+		return keys
+	}
+	if expected := []string{
+		" c.example.com CNAME a.example.com.",
+		" d.example.com TXT \"new\"",
+	}; !reflect.DeepEqual(ids(create), expected) {
+		t.Errorf("Created records are %v, expected %v", ids(create), expected)
+	}
+	if expected := []string{
+		"2 a.example.com A 192.0.2.2",
+		"3 b.example.com A 192.0.2.3",
+	}; !reflect.DeepEqual(ids(update), expected) {
+		t.Errorf("Updated records are %v, expected %v", ids(update), expected)
+	}
+	if expected := []string{
+		"4 c.example.com A 192.0.2.4",
+		"5 e.example.com TXT \"old\"",
+	}; !reflect.DeepEqual(ids(remove), expected) {
+		t.Errorf("Deleted records are %v, expected %v", ids(remove), expected)
+	}
+}
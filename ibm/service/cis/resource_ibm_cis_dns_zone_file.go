@@ -0,0 +1,405 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	cisDNSZoneFile              = "file"
+	cisDNSZoneFileContent       = "content"
+	cisDNSZoneFileIgnoreProxied = "ignore_proxied"
+	cisDNSZoneFileIgnoreTypes   = "ignore_types"
+	cisDNSZoneFileIgnoreNames   = "ignore_names"
+	cisDNSZoneFileRecords       = "records"
+	cisDNSZoneFilePerPage       = 1000
+)
+
+func ResourceIBMCISDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCISDNSZoneFileCreate,
+		ReadContext:   resourceIBMCISDNSZoneFileRead,
+		UpdateContext: resourceIBMCISDNSZoneFileUpdate,
+		DeleteContext: resourceIBMCISDNSZoneFileDelete,
+		CustomizeDiff: resourceIBMCISDNSZoneFileCustomizeDiff,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_dns_zone_file",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSZoneFile: {
+				Type:         schema.TypeString,
+				Description:  "Path of the BIND zone file of the domain",
+				Optional:     true,
+				ExactlyOneOf: []string{cisDNSZoneFile, cisDNSZoneFileContent},
+			},
+			cisDNSZoneFileContent: {
+				Type:         schema.TypeString,
+				Description:  "BIND zone file content of the domain",
+				Optional:     true,
+				ExactlyOneOf: []string{cisDNSZoneFile, cisDNSZoneFileContent},
+			},
+			cisDNSZoneFileIgnoreProxied: {
+				Type:        schema.TypeBool,
+				Description: "Leave the proxied records of the domain alone",
+				Optional:    true,
+				Default:     true,
+			},
+			cisDNSZoneFileIgnoreTypes: {
+				Type:        schema.TypeSet,
+				Description: "Record types which are left alone",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			cisDNSZoneFileIgnoreNames: {
+				Type:        schema.TypeList,
+				Description: "Regular expressions matching the names of the records which are left alone",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+			},
+			cisZoneName: {
+				Type:        schema.TypeString,
+				Description: "zone name",
+				Computed:    true,
+			},
+			cisDNSZoneFileRecords: {
+				Type:        schema.TypeList,
+				Description: "Records of the domain managed by the zone file",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISDNSZoneFileValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	ibmCISDNSZoneFileValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_zone_file",
+		Schema:       validateSchema}
+	return &ibmCISDNSZoneFileValidator
+}
+
+func resourceIBMCISDNSZoneFileCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+
+	if err := syncCISDNSZoneFile(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMCISDNSZoneFileRead(context, d, meta)
+}
+
+func resourceIBMCISDNSZoneFileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the zone file ID %s: %v", d.Id(), err))
+	}
+	zoneName, response, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Zone %s is not found", zoneID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	records, _, err := listCISDNSZoneRecords(meta, crn, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	records = cisDNSZoneFileFilter(d).filter(records)
+	sortZoneRecords(records)
+	lines := make([]string, 0, len(records))
+	for _, r := range records {
+		lines = append(lines, r.String())
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisZoneName, zoneName)
+	d.Set(cisDNSZoneFileRecords, lines)
+	return nil
+}
+
+func resourceIBMCISDNSZoneFileUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := syncCISDNSZoneFile(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMCISDNSZoneFileRead(context, d, meta)
+}
+
+// resourceIBMCISDNSZoneFileDelete deletes the records managed by the zone
+// file, the ignored records are kept.
+func resourceIBMCISDNSZoneFileDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the zone file ID %s: %v", d.Id(), err))
+	}
+	managed := map[string]bool{}
+	for _, line := range d.Get(cisDNSZoneFileRecords).([]interface{}) {
+		managed[line.(string)] = true
+	}
+	records, _, err := listCISDNSZoneRecords(meta, crn, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	remove := []zoneRecord{}
+	for _, r := range cisDNSZoneFileFilter(d).filter(records) {
+		if managed[r.String()] {
+			remove = append(remove, r)
+		}
+	}
+	if err := applyCISDNSZoneRecords(meta, crn, zoneID, nil, nil, remove); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMCISDNSZoneFileCustomizeDiff plans the records of the zone file,
+// so that both the changes of the file and the drift of the zone are shown.
+func resourceIBMCISDNSZoneFileCustomizeDiff(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{cisID, cisDomainID, cisDNSZoneFile, cisDNSZoneFileContent, cisDNSZoneFileIgnoreProxied, cisDNSZoneFileIgnoreTypes, cisDNSZoneFileIgnoreNames} {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed(cisDNSZoneFileRecords)
+		}
+	}
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	zoneName, _, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	records, err := readCISDNSZoneFile(d, zoneName)
+	if err != nil {
+		return err
+	}
+	sortZoneRecords(records)
+	lines := []string{}
+	seen := map[string]bool{}
+	for _, r := range records {
+		if line := r.String(); !seen[line] {
+			seen[line] = true
+			lines = append(lines, line)
+		}
+	}
+
+	old := []string{}
+	for _, line := range d.Get(cisDNSZoneFileRecords).([]interface{}) {
+		old = append(old, line.(string))
+	}
+	if d.Id() == "" || strings.Join(old, "\n") != strings.Join(lines, "\n") {
+		return d.SetNew(cisDNSZoneFileRecords, lines)
+	}
+	return nil
+}
+
+// cisDNSZoneFileSource is implemented by schema.ResourceData and
+// schema.ResourceDiff.
+type cisDNSZoneFileSource interface {
+	Get(string) interface{}
+}
+
+// readCISDNSZoneFile parses the zone file or content, and returns its records
+// which are not ignored.
+func readCISDNSZoneFile(d cisDNSZoneFileSource, zoneName string) ([]zoneRecord, error) {
+	content := d.Get(cisDNSZoneFileContent).(string)
+	if file := d.Get(cisDNSZoneFile).(string); file != "" {
+		buf, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error reading the zone file %s: %v", file, err)
+		}
+		content = string(buf)
+	}
+	records, err := parseZoneFile(content, zoneName)
+	if err != nil {
+		return nil, err
+	}
+	return cisDNSZoneFileFilter(d).filter(records), nil
+}
+
+func cisDNSZoneFileFilter(d cisDNSZoneFileSource) zoneRecordFilter {
+	f := zoneRecordFilter{
+		proxied: d.Get(cisDNSZoneFileIgnoreProxied).(bool),
+		types:   map[string]bool{},
+	}
+	for _, t := range d.Get(cisDNSZoneFileIgnoreTypes).(*schema.Set).List() {
+		f.types[strings.ToUpper(t.(string))] = true
+	}
+	for _, name := range d.Get(cisDNSZoneFileIgnoreNames).([]interface{}) {
+		// The expressions are checked by the schema
+		f.names = append(f.names, regexp.MustCompile(name.(string)))
+	}
+	return f
+}
+
+// syncCISDNSZoneFile applies the records of the zone file to the zone.
+func syncCISDNSZoneFile(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the zone file ID %s: %v", d.Id(), err)
+	}
+	zoneName, _, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	desired, err := readCISDNSZoneFile(d, zoneName)
+	if err != nil {
+		return err
+	}
+	live, _, err := listCISDNSZoneRecords(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	create, update, remove := diffZoneRecords(desired, cisDNSZoneFileFilter(d).filter(live))
+	log.Printf("[INFO] Zone %s: %d records to create, %d to update and %d to delete",
+		zoneName, len(create), len(update), len(remove))
+	return applyCISDNSZoneRecords(meta, crn, zoneID, create, update, remove)
+}
+
+// applyCISDNSZoneRecords deletes, then updates, then creates records, so that
+// a record can be replaced by a CNAME record of the same name.
+func applyCISDNSZoneRecords(meta interface{}, crn, zoneID string, create, update, remove []zoneRecord) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	for _, r := range remove {
+		opt := sess.NewDeleteDnsRecordOptions(r.ID)
+		_, response, err := sess.DeleteDnsRecord(opt)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting the dns record %s: %v %s", r, err, response)
+		}
+	}
+	for _, r := range update {
+		opt := sess.NewUpdateDnsRecordOptions(r.ID)
+		opt.SetName(r.Name)
+		opt.SetType(r.Type)
+		opt.SetTTL(r.TTL)
+		if data := r.cisData(); data != nil {
+			opt.SetData(data)
+		} else {
+			opt.SetContent(r.Content)
+		}
+		if r.Type == cisDNSRecordTypeMX {
+			opt.SetPriority(r.Priority)
+		}
+		if _, response, err := sess.UpdateDnsRecord(opt); err != nil {
+			return fmt.Errorf("[ERROR] Error updating the dns record %s: %v %s", r, err, response)
+		}
+	}
+	for _, r := range create {
+		opt := sess.NewCreateDnsRecordOptions()
+		opt.SetName(r.Name)
+		opt.SetType(r.Type)
+		opt.SetTTL(r.TTL)
+		if data := r.cisData(); data != nil {
+			opt.SetData(data)
+		} else {
+			opt.SetContent(r.Content)
+		}
+		if r.Type == cisDNSRecordTypeMX {
+			opt.SetPriority(r.Priority)
+		}
+		if _, response, err := sess.CreateDnsRecord(opt); err != nil {
+			return fmt.Errorf("[ERROR] Error creating the dns record %s: %v %s", r, err, response)
+		}
+	}
+	return nil
+}
+
+func getCISZoneName(meta interface{}, crn, zoneID string) (string, *core.DetailedResponse, error) {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return "", nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	opt := cisClient.NewGetZoneOptions(zoneID)
+	result, response, err := cisClient.GetZone(opt)
+	if err != nil {
+		return "", response, fmt.Errorf("[ERROR] Error getting the zone %s: %v %s", zoneID, err, response)
+	}
+	if result.Result == nil || result.Result.Name == nil {
+		return "", response, fmt.Errorf("[ERROR] Error getting the zone %s: no zone name", zoneID)
+	}
+	return *result.Result.Name, response, nil
+}
+
+// listCISDNSZoneRecords lists all the records of a zone in their zone file
+// form, and the records which have none.
+func listCISDNSZoneRecords(meta interface{}, crn, zoneID string) ([]zoneRecord, []dnsrecordsv1.DnsrecordDetails, error) {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	records := []zoneRecord{}
+	unsupported := []dnsrecordsv1.DnsrecordDetails{}
+	for page := int64(1); ; page++ {
+		opt := sess.NewListAllDnsRecordsOptions()
+		opt.SetPage(page)
+		opt.SetPerPage(cisDNSZoneFilePerPage)
+		result, response, err := sess.ListAllDnsRecords(opt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error listing the dns records of the zone %s: %v %s", zoneID, err, response)
+		}
+		for _, record := range result.Result {
+			if r, ok := zoneRecordFromCIS(record); ok {
+				records = append(records, r)
+			} else {
+				unsupported = append(unsupported, record)
+			}
+		}
+		if len(result.Result) < cisDNSZoneFilePerPage {
+			break
+		}
+	}
+	return records, unsupported, nil
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSZoneFile_Basic(t *testing.T) {
+	name := "ibm_cis_dns_zone_file.test"
+	testDomain := uuid.New().String() + acc.CisDomainTest
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckCis(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckCisDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSZoneFileConfigBasic(testDomain, `
+www 300 IN A   192.168.0.10
+txt 300 IN TXT "test zone file"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_name", testDomain),
+					resource.TestCheckResourceAttr(name, "records.#", "2"),
+					resource.TestCheckResourceAttr(name, "records.0",
+						fmt.Sprintf("txt.%s.\t300\tIN\tTXT\t\"test zone file\"", testDomain)),
+					resource.TestCheckResourceAttr(name, "records.1",
+						fmt.Sprintf("www.%s.\t300\tIN\tA\t192.168.0.10", testDomain)),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisDNSZoneFileConfigBasic(testDomain, `
+www  600 IN A     192.168.0.11
+mail 600 IN CNAME www
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "2"),
+					resource.TestCheckResourceAttr(name, "records.0",
+						fmt.Sprintf("mail.%[1]s.\t600\tIN\tCNAME\twww.%[1]s.", testDomain)),
+					resource.TestCheckResourceAttr(name, "records.1",
+						fmt.Sprintf("www.%s.\t600\tIN\tA\t192.168.0.11", testDomain)),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "ignore_proxied"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSZoneFileConfigBasic(domain, content string) string {
+	return testAccCheckCisDomainConfigCisRIbasic("test", domain) + fmt.Sprintf(`
+	resource "ibm_cis_dns_zone_file" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = ibm_cis_domain.cis_domain.domain_id
+		content   = <<-EOT
+		%[1]s
+		EOT
+	}
+	`, content)
+}
@@ -6,10 +6,13 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"net/url"
 	"os"
 	"regexp"
 	"strings"
@@ -24,6 +27,7 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -42,6 +46,7 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: resourceIBMCOSBucketObjectCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"body": {
 				Type:        schema.TypeString,
@@ -60,6 +65,12 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				ForceNew:    true,
 				Description: "COS bucket location",
 			},
+			"cache_control": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "COS object Cache-Control header",
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -72,12 +83,30 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				ConflictsWith: []string{"content", "content_file"},
 				Description:   "COS object content in base64 encoding",
 			},
+			"content_disposition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "COS object Content-Disposition header",
+			},
+			"content_encoding": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "COS object Content-Encoding header",
+			},
 			"content_file": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"content", "content_base64"},
 				Description:   "COS object content file path",
 			},
+			"content_language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "COS object Content-Language header",
+			},
 			"content_length": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -85,6 +114,7 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 			},
 			"content_type": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "COS object content type",
 			},
@@ -96,10 +126,11 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "COS object MD5 hexdigest",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressCOSObjectMultipartETag,
+				Description:      "COS object MD5 hexdigest",
 			},
 			"key": {
 				Type:        schema.TypeString,
@@ -112,6 +143,67 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "COS object last modified date",
 			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateCOSObjectMetadata,
+				Description:  "COS object user metadata, sent as x-amz-meta- headers",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(5),
+				Description:  "The size in MiB of the parts of a multipart upload, 5 by default. Larger objects are uploaded in parts.",
+			},
+			"server_side_encryption": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+				ConflictsWith: []string{"sse_customer_key"},
+				Description:   "COS object server-side encryption algorithm",
+			},
+			"source_hash": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A hash of the content of the object, a change of which uploads the object again",
+			},
+			"sse_customer_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validateCOSObjectCustomerKey,
+				ConflictsWith: []string{"server_side_encryption", "sse_kms_key_id"},
+				Description:   "The base64 encoded 256-bit AES key to encrypt the object with (SSE-C)",
+			},
+			"sse_customer_key_md5": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded MD5 digest of the sse_customer_key",
+			},
+			"sse_kms_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				RequiredWith:  []string{"server_side_encryption"},
+				ConflictsWith: []string{"sse_customer_key"},
+				Description:   "The key to encrypt the object with when server_side_encryption is aws:kms",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "COS object tags",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "The number of parts of a multipart upload which are uploaded in parallel, 5 by default",
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -175,46 +267,8 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 
 	objectKey := d.Get("key").(string)
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
-	//if website redirect location if given for a an object
-	if v, ok := d.GetOk("website_redirect"); ok {
-		putInput.WebsiteRedirectLocation = aws.String(v.(string))
-	}
-
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := uploadCOSObject(ctx, d, s3Client, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
 	}
 	if v, ok := d.GetOk("object_lock_mode"); ok {
 		if d, ok := d.GetOk("object_lock_retain_until_date"); ok {
@@ -281,6 +335,8 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(objectKey),
 	}
+	sseAlgorithm, sseKey := cosObjectCustomerKey(d)
+	headInput.SSECustomerAlgorithm, headInput.SSECustomerKey = sseAlgorithm, sseKey
 
 	out, err := s3Client.HeadObject(headInput)
 	if err != nil {
@@ -294,7 +350,17 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("content_length", out.ContentLength)
 	d.Set("content_type", out.ContentType)
+	d.Set("cache_control", out.CacheControl)
+	d.Set("content_disposition", out.ContentDisposition)
+	d.Set("content_encoding", out.ContentEncoding)
+	d.Set("content_language", out.ContentLanguage)
 	d.Set("etag", strings.Trim(aws.StringValue(out.ETag), `"`))
+	d.Set("metadata", flattenCOSObjectMetadata(out.Metadata))
+	d.Set("server_side_encryption", out.ServerSideEncryption)
+	d.Set("sse_customer_key_md5", out.SSECustomerKeyMD5)
+	if out.SSEKMSKeyId != nil {
+		d.Set("sse_kms_key_id", out.SSEKMSKeyId)
+	}
 	if out.LastModified != nil {
 		d.Set("last_modified", out.LastModified.Format(time.RFC1123))
 	} else {
@@ -303,8 +369,10 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 
 	if isContentTypeAllowed(out.ContentType) {
 		getInput := s3.GetObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
+			Bucket:               aws.String(bucketName),
+			Key:                  aws.String(objectKey),
+			SSECustomerAlgorithm: sseAlgorithm,
+			SSECustomerKey:       sseKey,
 		}
 		out, err := s3Client.GetObject(&getInput)
 		if err != nil {
@@ -340,6 +408,17 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 	if out.WebsiteRedirectLocation != nil {
 		d.Set("website_redirect", out.WebsiteRedirectLocation)
 	}
+	// Tags are not readable with every access policy of the bucket, so a
+	// failure to read them keeps the tags of the state.
+	tagging, err := s3Client.GetObjectTagging(&s3.GetObjectTaggingInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		log.Printf("[WARN] Error getting tags of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+	} else {
+		d.Set("tags", flattenCOSObjectTags(tagging.TagSet))
+	}
 	d.Set("key", objectKey)
 	d.Set("version_id", out.VersionId)
 	d.Set("object_sql_url", "cos://"+bucketLocation+"/"+bucketName+"/"+objectKey)
@@ -362,53 +441,21 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
-
-		var body io.ReadSeeker
-
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-			}
-
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
+	if d.HasChanges(cosObjectUploadKeys...) {
+		if err := uploadCOSObject(ctx, d, s3Client, bucketName, objectKey); err != nil {
+			return diag.FromErr(err)
 		}
-
-		objectKey := d.Get("key").(string)
-
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
-		}
-		if d.HasChange("website_redirect") {
-			if v, ok := d.GetOk("website_redirect"); ok {
-				putInput.WebsiteRedirectLocation = aws.String(v.(string))
+	} else {
+		if d.HasChanges(cosObjectHeaderKeys...) {
+			if err := replaceCOSObjectHeaders(d, s3Client, bucketName, objectKey); err != nil {
+				return diag.FromErr(err)
 			}
 		}
-
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		if d.HasChange("tags") {
+			if err := putCOSObjectTags(d, s3Client, bucketName, objectKey); err != nil {
+				return diag.FromErr(err)
+			}
 		}
-
 	}
 	if d.HasChange("object_lock_legal_hold_status") {
 		putObjectLegalHoldInput := &s3.PutObjectLegalHoldInput{
@@ -739,3 +786,315 @@ func objectDatetoString(t *time.Time) string {
 	}
 	return t.Format(time.RFC3339)
 }
+
+// cosObjectUploadKeys are the arguments a change of which uploads the object
+// again, etag last.
+var cosObjectUploadKeys = []string{"content", "content_base64", "content_file", "source_hash", "server_side_encryption", "sse_customer_key", "sse_kms_key_id", "etag"}
+
+// cosObjectHeaderKeys are the arguments a change of which replaces the
+// headers and the metadata of the object, without uploading it again.
+var cosObjectHeaderKeys = []string{"cache_control", "content_disposition", "content_encoding", "content_language", "content_type", "metadata", "website_redirect"}
+
+// resourceIBMCOSBucketObjectCustomizeDiff plans an upload when the content
+// file changed since the last apply, by comparing the ETag of the object with
+// the ETag of the file, which is read part by part rather than at once.
+func resourceIBMCOSBucketObjectCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	// A configured etag is left to the configuration.
+	if raw := diff.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("etag").IsNull() {
+		return nil
+	}
+	if diff.HasChanges(cosObjectUploadKeys[:len(cosObjectUploadKeys)-1]...) {
+		return diff.SetNewComputed("etag")
+	}
+	path := diff.Get("content_file").(string)
+	if path == "" || !diff.NewValueKnown("content_file") {
+		return nil
+	}
+	// The ETag of an encrypted object is not the MD5 digest of its content,
+	// source_hash tells its changes.
+	if diff.Get("sse_customer_key").(string) != "" || diff.Get("server_side_encryption").(string) == s3.ServerSideEncryptionAwsKms {
+		return nil
+	}
+	etags, err := cosObjectFileETags(path, cosObjectPartSize(diff))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+	}
+	current := diff.Get("etag").(string)
+	for _, etag := range etags {
+		if etag == current {
+			return nil
+		}
+	}
+	log.Printf("[INFO] COS object file (%s) changed, its ETag %s differs from %s", path, etags[0], current)
+	return diff.SetNew("etag", etags[0])
+}
+
+// cosObjectFileETags returns the ETag of the file as uploaded by
+// uploadCOSObject in parts of partSize bytes and, when it has more than one
+// part, its ETag as uploaded in a single part. The ETag of a multipart upload
+// is the MD5 digest of the MD5 digests of its parts, followed by the number of
+// parts.
+func cosObjectFileETags(path string, partSize int64) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	// As the upload manager, grow the parts to stay within the maximum number
+	// of parts.
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = size/s3manager.MaxUploadParts + 1
+	}
+
+	whole, parts, count := md5.New(), md5.New(), 0
+	for {
+		part := md5.New()
+		n, err := io.CopyN(io.MultiWriter(whole, part), file, partSize)
+		if n > 0 {
+			parts.Write(part.Sum(nil))
+			count++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	single := hex.EncodeToString(whole.Sum(nil))
+	if count <= 1 {
+		return []string{single}, nil
+	}
+	return []string{fmt.Sprintf("%s-%d", hex.EncodeToString(parts.Sum(nil)), count), single}, nil
+}
+
+// suppressCOSObjectMultipartETag suppresses the difference between the ETag
+// of a content file uploaded in parts and its MD5 hexdigest, as configured
+// with filemd5.
+func suppressCOSObjectMultipartETag(k, old, new string, d *schema.ResourceData) bool {
+	path := d.Get("content_file").(string)
+	if path == "" || !strings.Contains(old, "-") || strings.Contains(new, "-") {
+		return false
+	}
+	etags, err := cosObjectFileETags(path, cosObjectPartSize(d))
+	return err == nil && len(etags) == 2 && etags[0] == old && etags[1] == new
+}
+
+type cosObjectConfig interface {
+	Get(string) interface{}
+}
+
+func cosObjectPartSize(d cosObjectConfig) int64 {
+	if v := d.Get("part_size").(int); v > 0 {
+		return int64(v) * 1024 * 1024
+	}
+	return s3manager.DefaultUploadPartSize
+}
+
+func cosObjectUploadConcurrency(d cosObjectConfig) int {
+	if v := d.Get("upload_concurrency").(int); v > 0 {
+		return v
+	}
+	return s3manager.DefaultUploadConcurrency
+}
+
+// cosObjectBody opens the content, content_base64 or content_file of the
+// object, the returned function closes it.
+func cosObjectBody(d *schema.ResourceData) (io.ReadSeeker, func(), error) {
+	if v, ok := d.GetOk("content"); ok {
+		return strings.NewReader(v.(string)), func() {}, nil
+	}
+	if v, ok := d.GetOk("content_base64"); ok {
+		contentRaw, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		return bytes.NewReader(contentRaw), func() {}, nil
+	}
+	if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+		return file, func() {
+			if err := file.Close(); err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}, nil
+	}
+	return bytes.NewReader(nil), func() {}, nil
+}
+
+// uploadCOSObject uploads the object with its headers, metadata and tags.
+// Objects larger than part_size are uploaded in parts, upload_concurrency at
+// a time, and content files are streamed rather than read at once.
+func uploadCOSObject(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	body, closeBody, err := cosObjectBody(d)
+	if err != nil {
+		return err
+	}
+	defer closeBody()
+
+	input := &s3manager.UploadInput{
+		Bucket:                  aws.String(bucketName),
+		Key:                     aws.String(objectKey),
+		Body:                    body,
+		CacheControl:            cosObjectString(d, "cache_control"),
+		ContentDisposition:      cosObjectString(d, "content_disposition"),
+		ContentEncoding:         cosObjectString(d, "content_encoding"),
+		ContentLanguage:         cosObjectString(d, "content_language"),
+		ContentType:             cosObjectString(d, "content_type"),
+		Metadata:                expandCOSObjectMetadata(d.Get("metadata").(map[string]interface{})),
+		ServerSideEncryption:    cosObjectString(d, "server_side_encryption"),
+		SSEKMSKeyId:             cosObjectString(d, "sse_kms_key_id"),
+		WebsiteRedirectLocation: cosObjectString(d, "website_redirect"),
+	}
+	input.SSECustomerAlgorithm, input.SSECustomerKey = cosObjectCustomerKey(d)
+	if tags := d.Get("tags").(map[string]interface{}); len(tags) > 0 {
+		query := url.Values{}
+		for k, v := range tags {
+			query.Set(k, v.(string))
+		}
+		input.Tagging = aws.String(query.Encode())
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = cosObjectPartSize(d)
+		u.Concurrency = cosObjectUploadConcurrency(d)
+	})
+	out, err := uploader.UploadWithContext(ctx, input)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	if out.UploadID != "" {
+		log.Printf("[INFO] Uploaded COS bucket (%s) object (%s) in parts of %d bytes, upload ID %s", bucketName, objectKey, cosObjectPartSize(d), out.UploadID)
+	}
+	return nil
+}
+
+// replaceCOSObjectHeaders replaces the headers and the metadata of the
+// object by copying it onto itself.
+func replaceCOSObjectHeaders(d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	input := &s3.CopyObjectInput{
+		Bucket:                  aws.String(bucketName),
+		Key:                     aws.String(objectKey),
+		CopySource:              aws.String((&url.URL{Path: bucketName + "/" + objectKey}).EscapedPath()),
+		MetadataDirective:       aws.String(s3.MetadataDirectiveReplace),
+		CacheControl:            cosObjectString(d, "cache_control"),
+		ContentDisposition:      cosObjectString(d, "content_disposition"),
+		ContentEncoding:         cosObjectString(d, "content_encoding"),
+		ContentLanguage:         cosObjectString(d, "content_language"),
+		ContentType:             cosObjectString(d, "content_type"),
+		Metadata:                expandCOSObjectMetadata(d.Get("metadata").(map[string]interface{})),
+		ServerSideEncryption:    cosObjectString(d, "server_side_encryption"),
+		SSEKMSKeyId:             cosObjectString(d, "sse_kms_key_id"),
+		WebsiteRedirectLocation: cosObjectString(d, "website_redirect"),
+	}
+	input.SSECustomerAlgorithm, input.SSECustomerKey = cosObjectCustomerKey(d)
+	input.CopySourceSSECustomerAlgorithm, input.CopySourceSSECustomerKey = input.SSECustomerAlgorithm, input.SSECustomerKey
+	if _, err := s3Client.CopyObject(input); err != nil {
+		return fmt.Errorf("[ERROR] Error replacing the metadata of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+func putCOSObjectTags(d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	tags := d.Get("tags").(map[string]interface{})
+	if len(tags) == 0 {
+		if _, err := s3Client.DeleteObjectTagging(&s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		}); err != nil {
+			return fmt.Errorf("[ERROR] Error deleting the tags of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+		return nil
+	}
+	tagSet := make([]*s3.Tag, 0, len(tags))
+	for k, v := range tags {
+		tagSet = append(tagSet, &s3.Tag{Key: aws.String(k), Value: aws.String(v.(string))})
+	}
+	if _, err := s3Client.PutObjectTagging(&s3.PutObjectTaggingInput{
+		Bucket:  aws.String(bucketName),
+		Key:     aws.String(objectKey),
+		Tagging: &s3.Tagging{TagSet: tagSet},
+	}); err != nil {
+		return fmt.Errorf("[ERROR] Error putting the tags of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+func cosObjectString(d *schema.ResourceData, key string) *string {
+	if v, ok := d.GetOk(key); ok {
+		return aws.String(v.(string))
+	}
+	return nil
+}
+
+// cosObjectCustomerKey returns the algorithm and the key of the SSE-C
+// encryption of the object, or nil when it has none.
+func cosObjectCustomerKey(d *schema.ResourceData) (*string, *string) {
+	v, ok := d.GetOk("sse_customer_key")
+	if !ok {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		return nil, nil
+	}
+	return aws.String(s3.ServerSideEncryptionAes256), aws.String(string(key))
+}
+
+func expandCOSObjectMetadata(m map[string]interface{}) map[string]*string {
+	if len(m) == 0 {
+		return nil
+	}
+	metadata := make(map[string]*string, len(m))
+	for k, v := range m {
+		metadata[k] = aws.String(v.(string))
+	}
+	return metadata
+}
+
+// flattenCOSObjectMetadata returns the metadata with lower case keys, as the
+// keys of the x-amz-meta- headers come back in canonical case.
+func flattenCOSObjectMetadata(metadata map[string]*string) map[string]interface{} {
+	m := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		m[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	return m
+}
+
+func flattenCOSObjectTags(tagSet []*s3.Tag) map[string]interface{} {
+	m := make(map[string]interface{}, len(tagSet))
+	for _, tag := range tagSet {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return m
+}
+
+func validateCOSObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf("%s: key %q must be lower case", k, key))
+		}
+	}
+	return
+}
+
+func validateCOSObjectCustomerKey(v interface{}, k string) (ws []string, errors []error) {
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil || len(key) != 32 {
+		errors = append(errors, fmt.Errorf("%s must be a base64 encoded 256-bit key", k))
+	}
+	return
+}
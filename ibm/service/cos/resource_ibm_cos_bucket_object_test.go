@@ -4,17 +4,27 @@
 package cos_test
 
 import (
+	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccIBMCOSBucketObject_basic(t *testing.T) {
@@ -508,3 +518,165 @@ func testAccIBMCOSBucketObjectlock_legalhold_off(name string, instanceCRN string
    			force_delete = true
 		}`, name, instanceCRN, objectBody)
 }
+
+// testUnitCOSObjectETag returns the ETag of a multipart upload of content.
+func testUnitCOSObjectETag(content []byte, partSize int) string {
+	var sums []byte
+	parts := 0
+	for start := 0; start < len(content); start += partSize {
+		end := start + partSize
+		if end > len(content) {
+			end = len(content)
+		}
+		sum := md5.Sum(content[start:end])
+		sums = append(sums, sum[:]...)
+		parts++
+	}
+	sum := md5.Sum(sums)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts)
+}
+
+func TestUnitIBMCOSBucketObject_multipart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "object.bin")
+	content := make([]byte, 11<<20)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	etag := testUnitCOSObjectETag(content, 5<<20)
+	head := func(owner string) map[string]string {
+		return map[string]string{
+			"ETag":             `"` + etag + `"`,
+			"Content-Type":     "application/octet-stream",
+			"Cache-Control":    "max-age=60",
+			"X-Amz-Meta-Owner": owner,
+		}
+	}
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "POST", Path: "/identity/token", Body: []byte(`{"access_token":"` + unittest.MockIAMToken() + `","refresh_token":"refresh","token_type":"Bearer","expires_in":3600,"expiration":4102444800}`)},
+		unittest.Fixture{Method: "POST", Path: "/bucket/object.bin", Query: map[string]string{"uploadId": "upload-1"}, Times: 1, NextState: "uploaded", Body: []byte(`<CompleteMultipartUploadResult><Bucket>bucket</Bucket><Key>object.bin</Key><ETag>"` + etag + `"</ETag></CompleteMultipartUploadResult>`)},
+		unittest.Fixture{Method: "POST", Path: "/bucket/object.bin", Times: 1, Body: []byte(`<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>object.bin</Key><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`)},
+		unittest.Fixture{Method: "PUT", Path: "/bucket/object.bin", Query: map[string]string{"partNumber": "1"}, Times: 1, Headers: map[string]string{"ETag": `"part-1"`}},
+		unittest.Fixture{Method: "PUT", Path: "/bucket/object.bin", Query: map[string]string{"partNumber": "2"}, Times: 1, Headers: map[string]string{"ETag": `"part-2"`}},
+		unittest.Fixture{Method: "PUT", Path: "/bucket/object.bin", Query: map[string]string{"partNumber": "3"}, Times: 1, Headers: map[string]string{"ETag": `"part-3"`}},
+		unittest.Fixture{Method: "HEAD", Path: "/bucket/object.bin", State: "uploaded", Headers: head("team")},
+		unittest.Fixture{Method: "HEAD", Path: "/bucket/object.bin", State: "copied", Headers: head("other-team")},
+		// The tags of the copy cannot be read, which keeps the tags of the state.
+		unittest.Fixture{Method: "GET", Path: "/bucket/object.bin", Query: map[string]string{"tagging": ""}, State: "copied", Status: 403, Body: []byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)},
+		unittest.Fixture{Method: "GET", Path: "/bucket/object.bin", Query: map[string]string{"tagging": ""}, Body: []byte(`<Tagging><TagSet><Tag><Key>env</Key><Value>test</Value></Tag></TagSet></Tagging>`)},
+		// Changing the metadata alone copies the object onto itself.
+		unittest.Fixture{Method: "PUT", Path: "/bucket/object.bin", Times: 1, NextState: "copied", Body: []byte(`<CopyObjectResult><ETag>"` + etag + `"</ETag></CopyObjectResult>`)},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_COS_ENDPOINT": "", "IBMCLOUD_IAM_API_ENDPOINT": ""})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	r := p.ResourcesMap["ibm_cos_bucket_object"]
+	config := func(owner string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"bucket_crn":      "crn:v1:bluemix:public:cloud-object-storage:global:a/" + unittest.MockAccountID + ":instance-1:bucket:bucket",
+			"bucket_location": "us-south",
+			"key":             "object.bin",
+			"content_file":    path,
+			"cache_control":   "max-age=60",
+			"metadata":        map[string]interface{}{"owner": owner},
+			"tags":            map[string]interface{}{"env": "test"},
+			"part_size":       5,
+		})
+	}
+	apply := func(state *terraform.InstanceState, c *terraform.ResourceConfig) *terraform.InstanceState {
+		t.Helper()
+		diff, err := r.Diff(context.Background(), state, c, p.Meta())
+		if err != nil {
+			t.Fatalf("Diff returned an error: %s", err)
+		}
+		state, diags := r.Apply(context.Background(), state, diff, p.Meta())
+		if diags.HasError() {
+			t.Fatalf("Apply returned an error: %v", diags)
+		}
+		return state
+	}
+
+	// The file is uploaded in three parts, with its headers, metadata and tags
+	// sent when the upload starts.
+	state := apply(nil, config("team"))
+	var parts []int
+	for _, req := range m.Requests() {
+		switch {
+		case req.Method == "PUT" && strings.Contains(req.Query, "partNumber"):
+			parts = append(parts, len(req.Body))
+		case req.Method == "POST" && req.Query == "uploads=":
+			for k, v := range map[string]string{"Cache-Control": "max-age=60", "X-Amz-Meta-Owner": "team", "X-Amz-Tagging": "env=test"} {
+				if req.Header.Get(k) != v {
+					t.Errorf("Create sent %s %q, expected %q", k, req.Header.Get(k), v)
+				}
+			}
+		}
+	}
+	sort.Ints(parts)
+	if fmt.Sprint(parts) != fmt.Sprint([]int{1 << 20, 5 << 20, 5 << 20}) {
+		t.Errorf("Create uploaded parts of %v bytes, expected two parts of %d bytes and a part of %d bytes", parts, 5<<20, 1<<20)
+	}
+	for k, v := range map[string]string{"etag": etag, "metadata.owner": "team", "tags.env": "test", "cache_control": "max-age=60"} {
+		if state.Attributes[k] != v {
+			t.Errorf("Create set %s to %q, expected %q", k, state.Attributes[k], v)
+		}
+	}
+
+	// The ETag of the unchanged file matches the ETag of the object.
+	if diff, err := r.Diff(context.Background(), state, config("team"), p.Meta()); err != nil || diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("Diff planned a change of the unchanged file: %v %v", diff, err)
+	}
+	// As does the filemd5 of the file, which is not the ETag of a multipart
+	// upload.
+	md5Config := config("team")
+	md5Config.Config["etag"] = fmt.Sprintf("%x", md5.Sum(content))
+	md5Config.Raw["etag"] = md5Config.Config["etag"]
+	if diff, err := r.Diff(context.Background(), state, md5Config, p.Meta()); err != nil || diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("Diff planned a change of the etag of the unchanged file: %v %v", diff.Attributes["etag"], err)
+	}
+	content[len(content)-1]++
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if diff, err := r.Diff(context.Background(), state, config("team"), p.Meta()); err != nil || diff == nil || diff.Attributes["etag"] == nil || diff.Attributes["etag"].New != testUnitCOSObjectETag(content, 5<<20) {
+		t.Errorf("Diff did not plan the upload of the changed file: %v %v", diff, err)
+	}
+	content[len(content)-1]--
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The headers, metadata and tags read from COS are kept when they are not
+	// in the configuration, as when they were set outside of Terraform.
+	bare := config("team")
+	for _, k := range []string{"cache_control", "metadata", "tags"} {
+		delete(bare.Config, k)
+		delete(bare.Raw, k)
+	}
+	if diff, err := r.Diff(context.Background(), state, bare, p.Meta()); err != nil || diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("Diff planned the removal of the values read from COS: %v %v", diff, err)
+	}
+
+	// A change of the metadata replaces it without uploading the file again.
+	requests := len(m.Requests())
+	state = apply(state, config("other-team"))
+	for _, req := range m.Requests()[requests:] {
+		if req.Method == "PUT" && (req.Header.Get("X-Amz-Copy-Source") != "bucket/object.bin" || req.Header.Get("X-Amz-Metadata-Directive") != "REPLACE" || req.Header.Get("X-Amz-Meta-Owner") != "other-team" || len(req.Body) > 0) {
+			t.Errorf("Update sent PUT %s?%s with headers %v, expected a copy of the object", req.Path, req.Query, req.Header)
+		}
+	}
+	for k, v := range map[string]string{"metadata.owner": "other-team", "tags.env": "test"} {
+		if state.Attributes[k] != v {
+			t.Errorf("Update set %s to %q, expected %q", k, state.Attributes[k], v)
+		}
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
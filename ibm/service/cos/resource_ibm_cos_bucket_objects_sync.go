@@ -0,0 +1,503 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The local directory synced to the bucket",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The prefix of the keys of the synced objects, for example site/",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Patterns of the paths, relative to source_dir, or of the names of the files and directories which are not synced, for example *.tmp or drafts",
+			},
+			"delete_orphans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under key_prefix which have no file in source_dir, key_prefix must be set",
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the locked versions of the synced objects, by removing their legal hold, when the objects are deleted",
+			},
+			"cache_control": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Cache-Control header of the uploaded objects",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The content types of the uploaded objects by file extension, for example .md = text/markdown, which take precedence over the inferred content types",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(5),
+				Description:  "The size in MiB of the parts of a multipart upload, 5 by default. Larger files are uploaded in parts.",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "The number of files which are uploaded in parallel, 5 by default",
+			},
+			"manifest": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ETags of the synced objects by key",
+			},
+		},
+	}
+}
+
+// cosSyncFile is a file of the source directory and the key of its object.
+type cosSyncFile struct {
+	path  string
+	key   string
+	etags []string
+}
+
+// resourceIBMCOSBucketObjectsSyncCustomizeDiff plans a sync when the manifest
+// of the source directory differs from the manifest of the bucket.
+func resourceIBMCOSBucketObjectsSyncCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// Without a prefix every object of the bucket is an orphan of the
+	// directory, and deleting the resource would empty the bucket.
+	if diff.Get("delete_orphans").(bool) && diff.NewValueKnown("key_prefix") && diff.Get("key_prefix").(string) == "" {
+		return fmt.Errorf("[ERROR] key_prefix must be set when delete_orphans is set")
+	}
+	if diff.Id() == "" || !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("exclude") {
+		return nil
+	}
+	files, err := listCOSSyncFiles(diff)
+	if err != nil {
+		return err
+	}
+	current := diff.Get("manifest").(map[string]interface{})
+	manifest := make(map[string]interface{}, len(files))
+	for _, file := range files {
+		manifest[file.key] = file.etags[0]
+		if etag, ok := current[file.key].(string); ok && cosSyncETagMatches(file, etag) {
+			manifest[file.key] = etag
+		}
+	}
+	if len(manifest) == len(current) {
+		changed := false
+		for k, v := range manifest {
+			if current[k] != v {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+	return diff.SetNew("manifest", manifest)
+}
+
+// listCOSSyncFiles returns the files of the source directory which are not
+// excluded, with their ETags as computed by cosObjectFileETags. The excluded
+// directories are not walked.
+func listCOSSyncFiles(d cosObjectConfig) ([]cosSyncFile, error) {
+	dir := d.Get("source_dir").(string)
+	prefix := d.Get("key_prefix").(string)
+	var exclude []string
+	for _, v := range d.Get("exclude").([]interface{}) {
+		exclude = append(exclude, v.(string))
+	}
+	partSize := cosObjectPartSize(d)
+
+	var files []cosSyncFile
+	err := filepath.WalkDir(dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == dir || !entry.IsDir() && !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		for _, pattern := range exclude {
+			ok, _ := path.Match(pattern, rel)
+			if !ok {
+				ok, _ = path.Match(pattern, path.Base(rel))
+			}
+			if ok && entry.IsDir() {
+				return filepath.SkipDir
+			} else if ok {
+				return nil
+			}
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		etags, err := cosObjectFileETags(p, partSize)
+		if err != nil {
+			return err
+		}
+		files = append(files, cosSyncFile{path: p, key: prefix + rel, etags: etags})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", dir, err)
+	}
+	return files, nil
+}
+
+// cosSyncETagMatches reports whether an ETag is the ETag of the file, as
+// uploaded in parts or in a single part.
+func cosSyncETagMatches(file cosSyncFile, etag string) bool {
+	for _, e := range file.etags {
+		if e == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, d.Get("key_prefix").(string), d.Get("bucket_location").(string)))
+	if err := syncCOSBucketObjects(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := syncCOSBucketObjects(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+// syncCOSBucketObjects uploads the files of the source directory whose ETag
+// differs from the ETag of their object, upload_concurrency at a time, and
+// deletes the orphan objects when delete_orphans is set. When cache_control
+// or content_types change, the other objects are copied onto themselves with
+// the new headers.
+func syncCOSBucketObjects(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	s3Client, bucketName, err := cosSyncClient(d, m)
+	if err != nil {
+		return err
+	}
+	files, err := listCOSSyncFiles(d)
+	if err != nil {
+		return err
+	}
+	remote, err := listCOSSyncObjects(ctx, s3Client, bucketName, d.Get("key_prefix").(string))
+	if err != nil {
+		return err
+	}
+
+	var changed []cosSyncFile
+	local := make(map[string]bool, len(files))
+	// upToDate are the files whose object only needs its headers replaced.
+	upToDate := map[string]bool{}
+	replaceHeaders := d.HasChanges("cache_control", "content_types")
+	for _, file := range files {
+		local[file.key] = true
+		if etag, ok := remote[file.key]; !ok || !cosSyncETagMatches(file, etag) {
+			changed = append(changed, file)
+		} else if replaceHeaders {
+			upToDate[file.key] = true
+			changed = append(changed, file)
+		}
+	}
+	log.Printf("[INFO] Syncing %d of %d files of %s to COS bucket (%s), %d by replacing their headers", len(changed), len(files), d.Get("source_dir"), bucketName, len(upToDate))
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = cosObjectPartSize(d)
+	})
+	queue := make(chan cosSyncFile)
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for i := 0; i < cosObjectUploadConcurrency(d); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range queue {
+				var err error
+				if upToDate[file.key] {
+					err = replaceCOSSyncFileHeaders(ctx, d, s3Client, bucketName, file)
+				} else {
+					err = uploadCOSSyncFile(ctx, d, uploader, bucketName, file)
+				}
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, file := range changed {
+		queue <- file
+	}
+	close(queue)
+	wg.Wait()
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		var orphans []string
+		for key := range remote {
+			if !local[key] {
+				orphans = append(orphans, key)
+			}
+		}
+		sort.Strings(orphans)
+		for _, key := range orphans {
+			log.Printf("[INFO] Deleting orphan object (%s) of COS bucket (%s)", key, bucketName)
+			if err := deleteAllCOSObjectVersions(s3Client, bucketName, key, d.Get("force_delete").(bool), false); err != nil {
+				return fmt.Errorf("[ERROR] Error deleting orphan object (%s) of COS bucket (%s): %s", key, bucketName, err)
+			}
+		}
+	}
+
+	manifest := make(map[string]interface{}, len(files))
+	for _, file := range files {
+		manifest[file.key] = file.etags[0]
+	}
+	d.Set("manifest", manifest)
+	return nil
+}
+
+func uploadCOSSyncFile(ctx context.Context, d *schema.ResourceData, uploader *s3manager.Uploader, bucketName string, file cosSyncFile) error {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", file.path, err)
+	}
+	defer body.Close()
+	contentType, err := cosSyncContentType(d, body)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", file.path, err)
+	}
+	input := &s3manager.UploadInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(file.key),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	}
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", file.key, bucketName, err)
+	}
+	return nil
+}
+
+// replaceCOSSyncFileHeaders replaces the headers of the object of an
+// unchanged file by copying it onto itself.
+func replaceCOSSyncFileHeaders(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName string, file cosSyncFile) error {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", file.path, err)
+	}
+	defer body.Close()
+	contentType, err := cosSyncContentType(d, body)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", file.path, err)
+	}
+	input := &s3.CopyObjectInput{
+		Bucket:            aws.String(bucketName),
+		Key:               aws.String(file.key),
+		CopySource:        aws.String((&url.URL{Path: bucketName + "/" + file.key}).EscapedPath()),
+		MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+		ContentType:       aws.String(contentType),
+	}
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+	if _, err := s3Client.CopyObjectWithContext(ctx, input); err != nil {
+		return fmt.Errorf("[ERROR] Error replacing the headers of object (%s) in COS bucket (%s): %s", file.key, bucketName, err)
+	}
+	return nil
+}
+
+// cosSyncContentType returns the content type of a file from content_types,
+// from its extension or else from its first 512 bytes.
+func cosSyncContentType(d *schema.ResourceData, file *os.File) (string, error) {
+	ext := strings.ToLower(filepath.Ext(file.Name()))
+	if v, ok := d.Get("content_types").(map[string]interface{})[ext]; ok {
+		return v.(string), nil
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType, nil
+	}
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
+
+func cosSyncClient(d *schema.ResourceData, m interface{}) (*s3.S3, string, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	parts := strings.Split(bucketCRN, ":bucket:")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, "", fmt.Errorf("[ERROR] Invalid COS bucket CRN (%s), expected an instance CRN followed by :bucket: and the bucket name", bucketCRN)
+	}
+	bucketName := parts[1]
+	instanceCRN := fmt.Sprintf("%s::", parts[0])
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+	s3Client, err := getS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return nil, "", err
+	}
+	return s3Client, bucketName, nil
+}
+
+// listCOSSyncObjects returns the ETags of the objects under the prefix by key.
+func listCOSSyncObjects(ctx context.Context, s3Client *s3.S3, bucketName, prefix string) (map[string]string, error) {
+	objects := map[string]string{}
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the objects of COS bucket (%s): %s", bucketName, err)
+	}
+	return objects, nil
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := cosSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	remote, err := listCOSSyncObjects(ctx, s3Client, bucketName, d.Get("key_prefix").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The manifest tracks the synced objects, and the orphans when they are
+	// deleted, so that their deletion is planned.
+	synced := d.Get("manifest").(map[string]interface{})
+	manifest := make(map[string]interface{}, len(synced))
+	for key, etag := range remote {
+		if _, ok := synced[key]; ok || d.Get("delete_orphans").(bool) {
+			manifest[key] = etag
+		}
+	}
+	d.Set("manifest", manifest)
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := cosSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keys := make([]string, 0, len(d.Get("manifest").(map[string]interface{})))
+	for key := range d.Get("manifest").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := deleteAllCOSObjectVersions(s3Client, bucketName, key, d.Get("force_delete").(bool), false); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting object (%s) of COS bucket (%s): %s", key, bucketName, err))
+		}
+	}
+	return nil
+}
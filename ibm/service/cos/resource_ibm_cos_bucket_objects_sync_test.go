@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCOSBucketObjectsSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-sync-%d", acc.RandIntRange(t, 10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, acc.CosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "id"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "manifest.site/cosObject.json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsSyncConfig(name string, instanceCRN string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_objects_sync" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source_dir      = "../../test-fixtures"
+			key_prefix      = "site/"
+			exclude         = ["*.tf", "*.sh"]
+			delete_orphans  = true
+		}`, name, instanceCRN)
+}
+
+func TestUnitIBMCOSBucketObjectsSync_orphans(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"index.html":   "<html><body>Hello</body></html>",
+		"css/site.css": "body { color: red; }",
+		"notes.tmp":    "excluded",
+		"drafts/a.md":  "excluded",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	etag := func(content string) string {
+		return fmt.Sprintf("%x", md5.Sum([]byte(content)))
+	}
+	list := func(objects ...string) []byte {
+		body := "<ListBucketResult><Name>bucket</Name><Prefix>site/</Prefix><IsTruncated>false</IsTruncated>"
+		for i := 0; i < len(objects); i += 2 {
+			body += fmt.Sprintf(`<Contents><Key>%s</Key><ETag>"%s"</ETag><Size>1</Size></Contents>`, objects[i], objects[i+1])
+		}
+		return []byte(body + "</ListBucketResult>")
+	}
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "POST", Path: "/identity/token", Body: []byte(`{"access_token":"` + unittest.MockIAMToken() + `","refresh_token":"refresh","token_type":"Bearer","expires_in":3600,"expiration":4102444800}`)},
+		unittest.Fixture{Method: "GET", Path: "/bucket", Query: map[string]string{"list-type": "2"}, State: "synced", Body: list("site/index.html", etag(files["index.html"]), "site/css/site.css", etag(files["css/site.css"]))},
+		unittest.Fixture{Method: "GET", Path: "/bucket", Query: map[string]string{"list-type": "2"}, Body: list("site/index.html", etag(files["index.html"]), "site/css/site.css", "stale", "site/old.html", "orphan")},
+		// Only the changed file is uploaded.
+		unittest.Fixture{Method: "PUT", Path: "/bucket/site/css/site.css", Times: 1, Headers: map[string]string{"ETag": `"` + etag(files["css/site.css"]) + `"`}},
+		// The orphan is deleted with its versions.
+		unittest.Fixture{Method: "GET", Path: "/bucket", Query: map[string]string{"prefix": "site/old.html"}, Body: []byte(`<ListVersionsResult><Name>bucket</Name><IsTruncated>false</IsTruncated><Version><Key>site/old.html</Key><VersionId>v1</VersionId></Version></ListVersionsResult>`)},
+		unittest.Fixture{Method: "DELETE", Path: "/bucket/site/old.html", Query: map[string]string{"versionId": "v1"}, Times: 1, Status: 204, NextState: "synced"},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_COS_ENDPOINT": "", "IBMCLOUD_IAM_API_ENDPOINT": ""})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	r := p.ResourcesMap["ibm_cos_bucket_objects_sync"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket_crn":      "crn:v1:bluemix:public:cloud-object-storage:global:a/" + unittest.MockAccountID + ":instance-1:bucket:bucket",
+		"bucket_location": "us-south",
+		"source_dir":      dir,
+		"key_prefix":      "site/",
+		"exclude":         []interface{}{"*.tmp", "drafts"},
+		"delete_orphans":  true,
+	})
+	diff, err := r.Diff(context.Background(), nil, config, p.Meta())
+	if err != nil {
+		t.Fatalf("Diff returned an error: %s", err)
+	}
+	state, diags := r.Apply(context.Background(), nil, diff, p.Meta())
+	if diags.HasError() {
+		t.Fatalf("Apply returned an error: %v", diags)
+	}
+	for _, req := range m.Requests() {
+		if req.Method == "PUT" && req.Header.Get("Content-Type") != "text/css; charset=utf-8" {
+			t.Errorf("Create uploaded %s with content type %q, expected text/css", req.Path, req.Header.Get("Content-Type"))
+		}
+	}
+	expected := map[string]string{
+		"manifest.%":                 "2",
+		"manifest.site/index.html":   etag(files["index.html"]),
+		"manifest.site/css/site.css": etag(files["css/site.css"]),
+	}
+	for k, v := range expected {
+		if state.Attributes[k] != v {
+			t.Errorf("Create set %s to %q, expected %q", k, state.Attributes[k], v)
+		}
+	}
+
+	// The synced directory plans no change, a changed file plans a sync.
+	if diff, err := r.Diff(context.Background(), state, config, p.Meta()); err != nil || diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("Diff planned a change of the synced directory: %v %v", diff, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html></html>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	diff, err = r.Diff(context.Background(), state, config, p.Meta())
+	if err != nil || diff == nil || diff.Attributes["manifest.site/index.html"] == nil || diff.Attributes["manifest.site/index.html"].New != etag("<html></html>") {
+		t.Errorf("Diff did not plan the sync of the changed file: %v %v", diff, err)
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// Orphans are only deleted under a prefix.
+	delete(config.Raw, "key_prefix")
+	delete(config.Config, "key_prefix")
+	if _, err := r.Diff(context.Background(), nil, config, p.Meta()); err == nil || !strings.Contains(err.Error(), "key_prefix must be set") {
+		t.Errorf("Diff of delete_orphans without key_prefix returned %v", err)
+	}
+
+	// A malformed CRN fails instead of panicking.
+	d := r.TestResourceData()
+	d.Set("bucket_crn", "crn:v1:bluemix:public:cloud-object-storage:global:a/"+unittest.MockAccountID+":instance-1")
+	if diags := r.ReadContext(context.Background(), d, p.Meta()); !diags.HasError() || !strings.Contains(diags[0].Summary, "Invalid COS bucket CRN") {
+		t.Errorf("Read of a malformed bucket CRN returned %v", diags)
+	}
+}
+
+func TestUnitIBMCOSBucketObjectsSync_headers(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"index.html":   "<html><body>Hello</body></html>",
+		"css/site.css": "body { color: red; }",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	etag := func(content string) string {
+		return fmt.Sprintf("%x", md5.Sum([]byte(content)))
+	}
+	list := fmt.Sprintf(`<ListBucketResult><Name>bucket</Name><Prefix>site/</Prefix><IsTruncated>false</IsTruncated>`+
+		`<Contents><Key>site/index.html</Key><ETag>"%s"</ETag><Size>1</Size></Contents>`+
+		`<Contents><Key>site/css/site.css</Key><ETag>"%s"</ETag><Size>1</Size></Contents></ListBucketResult>`, etag(files["index.html"]), etag(files["css/site.css"]))
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "POST", Path: "/identity/token", Body: []byte(`{"access_token":"` + unittest.MockIAMToken() + `","refresh_token":"refresh","token_type":"Bearer","expires_in":3600,"expiration":4102444800}`)},
+		unittest.Fixture{Method: "GET", Path: "/bucket", Query: map[string]string{"list-type": "2"}, Body: []byte(list)},
+		// The objects are copied onto themselves, not uploaded again.
+		unittest.Fixture{Method: "PUT", Path: "/bucket/site/index.html", Times: 1, Body: []byte(`<CopyObjectResult><ETag>"` + etag(files["index.html"]) + `"</ETag></CopyObjectResult>`)},
+		unittest.Fixture{Method: "PUT", Path: "/bucket/site/css/site.css", Times: 1, Body: []byte(`<CopyObjectResult><ETag>"` + etag(files["css/site.css"]) + `"</ETag></CopyObjectResult>`)},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_COS_ENDPOINT": "", "IBMCLOUD_IAM_API_ENDPOINT": ""})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	r := p.ResourcesMap["ibm_cos_bucket_objects_sync"]
+	config := func(cacheControl string) *terraform.ResourceConfig {
+		raw := map[string]interface{}{
+			"bucket_crn":      "crn:v1:bluemix:public:cloud-object-storage:global:a/" + unittest.MockAccountID + ":instance-1:bucket:bucket",
+			"bucket_location": "us-south",
+			"source_dir":      dir,
+			"key_prefix":      "site/",
+		}
+		if cacheControl != "" {
+			raw["cache_control"] = cacheControl
+		}
+		return terraform.NewResourceConfigRaw(raw)
+	}
+	apply := func(state *terraform.InstanceState, c *terraform.ResourceConfig) *terraform.InstanceState {
+		t.Helper()
+		diff, err := r.Diff(context.Background(), state, c, p.Meta())
+		if err != nil {
+			t.Fatalf("Diff returned an error: %s", err)
+		}
+		state, diags := r.Apply(context.Background(), state, diff, p.Meta())
+		if diags.HasError() {
+			t.Fatalf("Apply returned an error: %v", diags)
+		}
+		return state
+	}
+
+	// The synced objects are left as they are.
+	state := apply(nil, config(""))
+	if calls := m.Calls("PUT", "/bucket/site/index.html") + m.Calls("PUT", "/bucket/site/css/site.css"); calls != 0 {
+		t.Fatalf("Create sent %d PUT requests for the synced objects", calls)
+	}
+
+	// A change of cache_control replaces the headers of all the objects.
+	state = apply(state, config("max-age=60"))
+	for _, req := range m.Requests() {
+		if req.Method != "PUT" {
+			continue
+		}
+		contentType := map[string]string{"/bucket/site/index.html": "text/html; charset=utf-8", "/bucket/site/css/site.css": "text/css; charset=utf-8"}[req.Path]
+		if req.Header.Get("X-Amz-Copy-Source") != req.Path[1:] || req.Header.Get("X-Amz-Metadata-Directive") != "REPLACE" ||
+			req.Header.Get("Cache-Control") != "max-age=60" || req.Header.Get("Content-Type") != contentType || len(req.Body) > 0 {
+			t.Errorf("Update sent PUT %s with headers %v, expected a copy of the object with the new headers", req.Path, req.Header)
+		}
+	}
+	if state.Attributes["cache_control"] != "max-age=60" {
+		t.Errorf("Update set cache_control to %q", state.Attributes["cache_control"])
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
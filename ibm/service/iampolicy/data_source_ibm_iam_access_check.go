@@ -0,0 +1,641 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// Decisions of the access check
+const (
+	accessCheckAllow       = "allow"
+	accessCheckDeny        = "deny"
+	accessCheckConditional = "conditional"
+)
+
+var accessCheckSubjects = []string{"iam_id", "ibm_id", "iam_service_id", "profile_id"}
+
+// Data source to check whether a subject is allowed an action on a resource,
+// from the access policies of the subject and of its access groups
+func DataSourceIBMIAMAccessCheck() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMAccessCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: accessCheckSubjects,
+				Description:  "IAM ID of the user, service ID or trusted profile",
+			},
+			"ibm_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: accessCheckSubjects,
+				Description:  "The ibm id or email of user",
+			},
+			"iam_service_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: accessCheckSubjects,
+				Description:  "UUID of ServiceID",
+			},
+			"profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: accessCheckSubjects,
+				Description:  "UUID of Trusted Profile",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Action to check, for example cloud-object-storage.object.get",
+			},
+			"resource_crn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"resource_crn", "resource_attributes"},
+				Description:  "CRN of the target resource",
+			},
+			"resource_attributes": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				AtLeastOneOf: []string{"resource_crn", "resource_attributes"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Attributes of the target resource, like serviceName or resourceGroupId. They override the attributes of resource_crn",
+			},
+			"resource_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Access tags of the target resource, in the form key:value",
+			},
+			"resolved_iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the subject",
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a policy without rule conditions grants the action",
+			},
+			"decision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "allow, deny, or conditional when only policies with rule conditions grant the action",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names which grant the action",
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies of the subject which match the target resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the access group the policy is inherited from",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition",
+						},
+						"granted_roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition which grant the action",
+						},
+						"grants_action": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the policy grants the action",
+						},
+						"conditional": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the policy has rule conditions, which are not evaluated",
+						},
+						"template_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the policy template the policy is assigned from",
+						},
+						"template_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the policy template the policy is assigned from",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the Policy",
+						},
+						"resources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service name of the policy definition",
+									},
+									"resource_instance_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of resource instance of the policy definition",
+									},
+									"region": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Region of the policy definition",
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Resource type of the policy definition",
+									},
+									"resource": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Resource of the policy definition",
+									},
+									"resource_group_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the resource group.",
+									},
+									"service_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service type of the policy definition",
+									},
+									"service_group_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service group id of the policy definition",
+									},
+									"attributes": {
+										Type:        schema.TypeMap,
+										Computed:    true,
+										Description: "Set resource attributes in the form of 'name=value,name=value....",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMAccessCheckRead(d *schema.ResourceData, meta interface{}) error {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	accountID := userDetails.UserAccount
+
+	iamID, err := accessCheckSubjectIamID(d, accountID, meta)
+	if err != nil {
+		return err
+	}
+
+	target, err := accessCheckTarget(d.Get("resource_crn").(string), d.Get("resource_attributes").(map[string]interface{}), accountID)
+	if err != nil {
+		return err
+	}
+	serviceName := target["serviceName"]
+	if serviceName == "" {
+		return fmt.Errorf("[ERROR] The service name of the target resource is unknown, set resource_crn or the serviceName resource attribute")
+	}
+	// Policies on a resource group match the resource only with its group
+	if _, ok := target["resourceGroupId"]; !ok && d.Get("resource_crn").(string) != "" {
+		resourceGroupID, err := accessCheckResourceGroupID(meta, d.Get("resource_crn").(string))
+		if err != nil {
+			return err
+		}
+		if resourceGroupID != "" {
+			target["resourceGroupId"] = resourceGroupID
+		}
+	}
+	tags := flex.ExpandStringList(d.Get("resource_tags").(*schema.Set).List())
+	action := d.Get("action").(string)
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	// The roles of the target service and of the service of the action, the
+	// platform roles of which grant the actions of platform services
+	roles := accessCheckRoles{client: iamPolicyManagementClient, accountID: accountID}
+	if err := roles.load(serviceName); err != nil {
+		return err
+	}
+	if actionService, _, ok := strings.Cut(action, "."); ok {
+		if err := roles.load(actionService); err != nil {
+			return err
+		}
+	}
+
+	// The policies of the subject, then of its access groups
+	subjectPolicies, err := listAccessCheckPolicies(iamPolicyManagementClient, &iampolicymanagementv1.ListV2PoliciesOptions{
+		AccountID: core.StringPtr(accountID),
+		IamID:     core.StringPtr(iamID),
+		Type:      core.StringPtr("access"),
+	}, "")
+	if err != nil {
+		return err
+	}
+	accessGroupIDs, err := listAccessCheckGroups(meta, accountID, iamID)
+	if err != nil {
+		return err
+	}
+	for _, accessGroupID := range accessGroupIDs {
+		groupPolicies, err := listAccessCheckPolicies(iamPolicyManagementClient, &iampolicymanagementv1.ListV2PoliciesOptions{
+			AccountID:     core.StringPtr(accountID),
+			AccessGroupID: core.StringPtr(accessGroupID),
+			Type:          core.StringPtr("access"),
+		}, accessGroupID)
+		if err != nil {
+			return err
+		}
+		subjectPolicies = append(subjectPolicies, groupPolicies...)
+	}
+
+	decision := accessCheckDeny
+	grantingRoles := []string{}
+	policies := make([]map[string]interface{}, 0)
+	seen := map[string]bool{}
+	for _, sp := range subjectPolicies {
+		policy := sp.policy
+		if policy.ID == nil || seen[*policy.ID] || policy.Resource == nil || !accessCheckPolicyMatches(*policy.Resource, target, tags) {
+			continue
+		}
+		seen[*policy.ID] = true
+
+		// The roles of a policy on another service, like a policy on all the
+		// services of a resource group, are roles of that service
+		for _, a := range policy.Resource.Attributes {
+			if a.Key != nil && *a.Key == "serviceName" && a.Operator != nil && *a.Operator == "stringEquals" {
+				if err := roles.load(fmt.Sprint(a.Value)); err != nil {
+					return err
+				}
+			}
+		}
+		policyRoles, err := flex.GetRoleNamesFromPolicyResponse(policy, d, meta)
+		if err != nil {
+			return err
+		}
+		grantedRoles := []string{}
+		if control, ok := policy.Control.(*iampolicymanagementv1.ControlResponse); ok && control.Grant != nil {
+			for _, role := range control.Grant.Roles {
+				if role.RoleID == nil || !accessCheckActionIncluded(roles.actions[*role.RoleID], action) {
+					continue
+				}
+				grantedRoles = append(grantedRoles, roles.names[*role.RoleID])
+			}
+		}
+		conditional := policy.Rule != nil
+		if len(grantedRoles) > 0 {
+			switch {
+			case !conditional:
+				decision = accessCheckAllow
+			case decision == accessCheckDeny:
+				decision = accessCheckConditional
+			}
+			for _, role := range grantedRoles {
+				if !flex.StringContains(grantingRoles, role) {
+					grantingRoles = append(grantingRoles, role)
+				}
+			}
+		}
+
+		p := map[string]interface{}{
+			"id":              *policy.ID,
+			"access_group_id": sp.accessGroupID,
+			"roles":           policyRoles,
+			"granted_roles":   grantedRoles,
+			"grants_action":   len(grantedRoles) > 0,
+			"conditional":     conditional,
+			"resources":       flex.FlattenV2PolicyResource(*policy.Resource),
+		}
+		if policy.Description != nil {
+			p["description"] = *policy.Description
+		}
+		if policy.Template != nil {
+			p["template_id"] = flex.StringValue(policy.Template.ID)
+			p["template_version"] = flex.StringValue(policy.Template.Version)
+		}
+		policies = append(policies, p)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", iamID, action))
+	d.Set("resolved_iam_id", iamID)
+	d.Set("allowed", decision == accessCheckAllow)
+	d.Set("decision", decision)
+	d.Set("roles", grantingRoles)
+	d.Set("policies", policies)
+	return nil
+}
+
+// accessCheckSubjectIamID resolves the IAM ID of the user, service ID or
+// trusted profile
+func accessCheckSubjectIamID(d *schema.ResourceData, accountID string, meta interface{}) (string, error) {
+	if v, ok := d.GetOk("iam_id"); ok {
+		return v.(string), nil
+	}
+	if v, ok := d.GetOk("ibm_id"); ok {
+		return flex.GetIBMUniqueId(accountID, v.(string), meta)
+	}
+
+	iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return "", err
+	}
+	if v, ok := d.GetOk("iam_service_id"); ok {
+		serviceID, resp, err := iamClient.GetServiceID(&iamidentityv1.GetServiceIDOptions{
+			ID: core.StringPtr(v.(string)),
+		})
+		if err != nil || serviceID == nil {
+			return "", fmt.Errorf("[ERROR] Error Getting Service Id %s %s", err, resp)
+		}
+		return *serviceID.IamID, nil
+	}
+	profile, resp, err := iamClient.GetProfile(&iamidentityv1.GetProfileOptions{
+		ProfileID: core.StringPtr(d.Get("profile_id").(string)),
+	})
+	if err != nil || profile == nil {
+		return "", fmt.Errorf("[ERROR] Error getting profile ID %s %s", err, resp)
+	}
+	return *profile.IamID, nil
+}
+
+// accessCheckRoles are the names and actions of the roles of the services
+// loaded, by role CRN. The platform roles have the same CRN for every
+// service, so their actions are the actions of all the services loaded.
+type accessCheckRoles struct {
+	client    *iampolicymanagementv1.IamPolicyManagementV1
+	accountID string
+	loaded    map[string]bool
+	names     map[string]string
+	actions   map[string][]string
+}
+
+func (r *accessCheckRoles) load(serviceName string) error {
+	if r.loaded == nil {
+		r.loaded = map[string]bool{}
+		r.names = map[string]string{}
+		r.actions = map[string][]string{}
+	}
+	if serviceName == "" || r.loaded[serviceName] {
+		return nil
+	}
+	roleList, resp, err := r.client.ListRoles(&iampolicymanagementv1.ListRolesOptions{
+		AccountID:   core.StringPtr(r.accountID),
+		ServiceName: core.StringPtr(serviceName),
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the roles of %s: %s, %s", serviceName, err, resp)
+	}
+	r.loaded[serviceName] = true
+	for _, role := range roleList.CustomRoles {
+		r.add(*role.CRN, *role.DisplayName, role.Actions)
+	}
+	for _, role := range append(roleList.ServiceRoles, roleList.SystemRoles...) {
+		r.add(*role.CRN, *role.DisplayName, role.Actions)
+	}
+	return nil
+}
+
+func (r *accessCheckRoles) add(crn, name string, actions []string) {
+	r.names[crn] = name
+	for _, action := range actions {
+		if !flex.StringContains(r.actions[crn], action) {
+			r.actions[crn] = append(r.actions[crn], action)
+		}
+	}
+}
+
+// accessCheckResourceGroupID returns the resource group of the resource, or of
+// its service instance when the resource is not indexed, from global search
+func accessCheckResourceGroupID(meta interface{}, crn string) (string, error) {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+	crns := []string{crn}
+	if segments := strings.SplitN(crn, ":", 10); len(segments) == 10 && segments[7] != "" && (segments[8] != "" || segments[9] != "") {
+		crns = append(crns, strings.Join(segments[:8], ":")+"::")
+	}
+	for _, c := range crns {
+		options := globalsearchv2.SearchOptions{}
+		options.SetQuery(fmt.Sprintf("crn:\"%s\"", c))
+		options.SetFields([]string{"resource_group_id"})
+		result, resp, err := gsClient.Search(&options)
+		if err != nil {
+			return "", fmt.Errorf("[ERROR] Error searching the resource group of %s: %s %s", c, err, resp)
+		}
+		if len(result.Items) > 0 {
+			if resourceGroupID, ok := result.Items[0].GetProperty("resource_group_id").(string); ok && resourceGroupID != "" {
+				return resourceGroupID, nil
+			}
+		}
+	}
+	log.Printf("[WARN] The resource group of %s is not found, set the resourceGroupId resource attribute to match the policies on resource groups", crn)
+	return "", nil
+}
+
+// accessCheckSubjectPolicy is a policy of the subject, or of one of its access
+// groups
+type accessCheckSubjectPolicy struct {
+	policy        iampolicymanagementv1.V2PolicyTemplateMetaData
+	accessGroupID string
+}
+
+func listAccessCheckPolicies(client *iampolicymanagementv1.IamPolicyManagementV1, options *iampolicymanagementv1.ListV2PoliciesOptions, accessGroupID string) ([]accessCheckSubjectPolicy, error) {
+	pager, err := client.NewV2PoliciesPager(options)
+	if err != nil {
+		return nil, err
+	}
+	allPolicies, err := pager.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing policies: %s", err)
+	}
+	policies := make([]accessCheckSubjectPolicy, 0, len(allPolicies))
+	for _, policy := range allPolicies {
+		policies = append(policies, accessCheckSubjectPolicy{policy: policy, accessGroupID: accessGroupID})
+	}
+	return policies, nil
+}
+
+func listAccessCheckGroups(meta interface{}, accountID, iamID string) ([]string, error) {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return nil, err
+	}
+	pager, err := iamAccessGroupsClient.NewAccessGroupsPager(&iamaccessgroupsv2.ListAccessGroupsOptions{
+		AccountID:      core.StringPtr(accountID),
+		IamID:          core.StringPtr(iamID),
+		MembershipType: core.StringPtr("all"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	groups, err := pager.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the access groups of %s: %s", iamID, err)
+	}
+	accessGroupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		if group.ID != nil {
+			accessGroupIDs = append(accessGroupIDs, *group.ID)
+		}
+	}
+	return accessGroupIDs, nil
+}
+
+// accessCheckTarget returns the attributes of the target resource, from its
+// CRN of the form crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+func accessCheckTarget(crn string, attributes map[string]interface{}, accountID string) (map[string]string, error) {
+	target := map[string]string{
+		"accountId":   accountID,
+		"serviceType": "service",
+	}
+	if crn != "" {
+		segments := strings.SplitN(crn, ":", 10)
+		if len(segments) != 10 || segments[0] != "crn" {
+			return nil, fmt.Errorf("[ERROR] Invalid resource CRN %s", crn)
+		}
+		for key, i := range map[string]int{
+			"serviceName":     4,
+			"region":          5,
+			"serviceInstance": 7,
+			"resourceType":    8,
+			"resource":        9,
+		} {
+			if segments[i] != "" {
+				target[key] = segments[i]
+			}
+		}
+		if strings.HasPrefix(segments[6], "a/") {
+			target["accountId"] = strings.TrimPrefix(segments[6], "a/")
+		}
+	}
+	for key, value := range attributes {
+		target[key] = value.(string)
+	}
+	return target, nil
+}
+
+// accessCheckPolicyMatches tells whether every resource attribute and access
+// tag of the policy matches the target resource
+func accessCheckPolicyMatches(resource iampolicymanagementv1.V2PolicyResource, target map[string]string, tags []string) bool {
+	for _, a := range resource.Attributes {
+		if a.Key == nil || a.Operator == nil {
+			return false
+		}
+		value, present := target[*a.Key]
+		if !accessCheckConditionMatches(*a.Operator, a.Value, value, present) {
+			return false
+		}
+	}
+	for _, tag := range resource.Tags {
+		if tag.Key == nil || tag.Operator == nil {
+			return false
+		}
+		matched := false
+		for _, t := range tags {
+			key, value, _ := strings.Cut(t, ":")
+			if key == *tag.Key && accessCheckConditionMatches(*tag.Operator, flex.StringValue(tag.Value), value, true) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func accessCheckConditionMatches(operator string, expected interface{}, value string, present bool) bool {
+	values := []string{}
+	switch e := expected.(type) {
+	case []interface{}:
+		for _, v := range e {
+			values = append(values, fmt.Sprint(v))
+		}
+	case []string:
+		values = e
+	default:
+		values = append(values, fmt.Sprint(e))
+	}
+
+	switch operator {
+	case "stringExists":
+		return present == (fmt.Sprint(expected) == "true")
+	case "stringEquals", "stringEqualsAnyOf":
+		return present && flex.StringContains(values, value)
+	case "stringMatch", "stringMatchAnyOf":
+		if !present {
+			return false
+		}
+		for _, v := range values {
+			if accessCheckWildcard(v).MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// accessCheckWildcard converts a stringMatch value, where * matches any
+// characters and ? a single one, to a regular expression
+func accessCheckWildcard(pattern string) *regexp.Regexp {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	return regexp.MustCompile("^" + expr + "$")
+}
+
+// accessCheckActionIncluded tells whether the role actions include the action,
+// an action ending with * includes all the actions of that prefix
+func accessCheckActionIncluded(actions []string, action string) bool {
+	for _, a := range actions {
+		if a == action || (strings.HasSuffix(a, "*") && strings.HasPrefix(action, strings.TrimSuffix(a, "*"))) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAccessCheckDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acc.RandIntRange(t, 10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessCheckDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "decision", "allow"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "roles.0", "Reader"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "policies.0.grants_action", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.write", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.write", "decision", "deny"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.write", "policies.0.grants_action", "false"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.other_instance", "policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessCheckDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+
+resource "ibm_iam_service_id" "serviceID" {
+  name        = "%[1]s"
+  description = "Service ID for test"
+}
+
+resource "ibm_resource_instance" "instance" {
+  name     = "%[1]s"
+  service  = "kms"
+  plan     = "tiered-pricing"
+  location = "us-south"
+}
+
+resource "ibm_iam_service_policy" "policy" {
+  iam_service_id = ibm_iam_service_id.serviceID.id
+  roles          = ["Reader"]
+
+  resources {
+    service              = "kms"
+    resource_instance_id = element(split(":", ibm_resource_instance.instance.id), 7)
+  }
+}
+
+data "ibm_iam_access_check" "read" {
+  iam_service_id = ibm_iam_service_policy.policy.iam_service_id
+  action         = "kms.secrets.list"
+  resource_crn   = ibm_resource_instance.instance.crn
+}
+
+data "ibm_iam_access_check" "write" {
+  iam_service_id = ibm_iam_service_policy.policy.iam_service_id
+  action         = "kms.secrets.create"
+  resource_crn   = ibm_resource_instance.instance.crn
+}
+
+data "ibm_iam_access_check" "other_instance" {
+  iam_service_id = ibm_iam_service_policy.policy.iam_service_id
+  action         = "kms.secrets.list"
+  resource_attributes = {
+    serviceName     = "kms"
+    serviceInstance = "00000000-0000-0000-0000-000000000000"
+  }
+}`, name)
+}
+
+func TestUnitIBMIAMAccessCheckDataSource_resourceGroup(t *testing.T) {
+	bucketCRN := "crn:v1:bluemix:public:cloud-object-storage:global:a/" + unittest.MockAccountID + ":instance-1:bucket:bucket"
+	roles := func(serviceRoles, systemRoles string) []byte {
+		return []byte(`{"custom_roles":[],"service_roles":[` + serviceRoles + `],"system_roles":[` + systemRoles + `]}`)
+	}
+	reader := `{"crn":"crn:v1:bluemix:public:iam::::serviceRole:Reader","display_name":"Reader","actions":["cloud-object-storage.object.get"]}`
+	viewer := func(actions string) string {
+		return `{"crn":"crn:v1:bluemix:public:iam::::role:Viewer","display_name":"Viewer","actions":[` + actions + `]}`
+	}
+	policy := func(id, role, attributes string) string {
+		return `{"id":"` + id + `","type":"access","control":{"grant":{"roles":[{"role_id":"` + role + `"}]}},` +
+			`"subject":{"attributes":[{"key":"iam_id","operator":"stringEquals","value":"iam-ServiceId-1"}]},` +
+			`"resource":{"attributes":[{"key":"accountId","operator":"stringEquals","value":"` + unittest.MockAccountID + `"},` +
+			`{"key":"resourceGroupId","operator":"stringEquals","value":"rg-1"}` + attributes + `]}}`
+	}
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "POST", Path: "/identity/token", Body: []byte(`{"access_token":"` + unittest.MockIAMToken() + `","refresh_token":"refresh","token_type":"Bearer","expires_in":3600,"expiration":4102444800}`)},
+		// The resource group of the bucket is only known to global search.
+		unittest.Fixture{Method: "POST", Path: "/v3/resources/search", Body: []byte(`{"items":[{"crn":"` + bucketCRN + `","resource_group_id":"rg-1"}],"limit":10}`)},
+		unittest.Fixture{Method: "GET", Path: "/v2/roles", Query: map[string]string{"service_name": "cloud-object-storage"}, Body: roles(reader, viewer(`"cloud-object-storage.bucket.list"`))},
+		unittest.Fixture{Method: "GET", Path: "/v2/roles", Query: map[string]string{"service_name": "resource-controller"}, Body: roles("", viewer(`"resource-controller.instance.retrieve"`))},
+		unittest.Fixture{Method: "GET", Path: "/v2/roles", Query: map[string]string{"service_name": "alliamserviceroles"}, Body: roles(reader, viewer(""))},
+		unittest.Fixture{Method: "GET", Path: "/v2/policies", Query: map[string]string{"iam_id": "iam-ServiceId-1"}, Body: []byte(`{"policies":[` +
+			policy("policy-1", "crn:v1:bluemix:public:iam::::serviceRole:Reader", `,{"key":"serviceName","operator":"stringEquals","value":"cloud-object-storage"}`) + `,` +
+			policy("policy-2", "crn:v1:bluemix:public:iam::::role:Viewer", "") + `]}`)},
+		unittest.Fixture{Method: "GET", Path: "/v2/groups", Body: []byte(`{"limit":100,"offset":0,"total_count":0,"groups":[]}`)},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_IAM_API_ENDPOINT": "", "IBMCLOUD_GS_API_ENDPOINT": ""})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	dataSource := p.DataSourcesMap["ibm_iam_access_check"]
+	for action, role := range map[string]string{
+		// Granted by the policy on the service in the resource group of the bucket
+		"cloud-object-storage.object.get": "Reader",
+		// Granted by the platform role of the policy on all the services of
+		// the resource group
+		"resource-controller.instance.retrieve": "Viewer",
+	} {
+		d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+			"iam_id":       "iam-ServiceId-1",
+			"action":       action,
+			"resource_crn": bucketCRN,
+		})
+		if diags := dataSource.ReadContext(context.Background(), d, p.Meta()); diags.HasError() {
+			t.Fatalf("Read of %s failed: %v", action, diags)
+		}
+		if d.Get("decision") != "allow" || d.Get("roles.#") != 1 || d.Get("roles.0") != role || d.Get("policies.#") != 2 {
+			t.Errorf("Read of %s decided %v with roles %v and %v policies, expected allow with %s and 2 policies", action, d.Get("decision"), d.Get("roles"), d.Get("policies.#"), role)
+		}
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+func TestUnitAccessCheckTarget(t *testing.T) {
+	target, err := accessCheckTarget(
+		"crn:v1:bluemix:public:cloud-object-storage:global:a/acc2:inst1:bucket:my-bucket",
+		map[string]interface{}{"resourceGroupId": "rg1"}, "acc1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"accountId":       "acc2",
+		"serviceType":     "service",
+		"serviceName":     "cloud-object-storage",
+		"region":          "global",
+		"serviceInstance": "inst1",
+		"resourceType":    "bucket",
+		"resource":        "my-bucket",
+		"resourceGroupId": "rg1",
+	}
+	if !reflect.DeepEqual(target, expected) {
+		t.Errorf("Target is %v, expected %v", target, expected)
+	}
+	if _, err := accessCheckTarget("not-a-crn", nil, "acc1"); err == nil {
+		t.Errorf("Invalid CRN is accepted")
+	}
+}
+
+func TestUnitAccessCheckPolicyMatches(t *testing.T) {
+	attribute := func(key, operator string, value interface{}) iampolicymanagementv1.V2PolicyResourceAttribute {
+		return iampolicymanagementv1.V2PolicyResourceAttribute{Key: core.StringPtr(key), Operator: core.StringPtr(operator), Value: value}
+	}
+	target := map[string]string{
+		"accountId":       "acc1",
+		"serviceType":     "service",
+		"serviceName":     "cloud-object-storage",
+		"serviceInstance": "inst1",
+		"resourceType":    "bucket",
+		"resource":        "logs-2024",
+	}
+	for name, tc := range map[string]struct {
+		resource iampolicymanagementv1.V2PolicyResource
+		tags     []string
+		matches  bool
+	}{
+		"all services": {
+			resource: iampolicymanagementv1.V2PolicyResource{Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+				attribute("accountId", "stringEquals", "acc1"),
+				attribute("serviceType", "stringEquals", "service"),
+			}},
+			matches: true,
+		},
+		"other account": {
+			resource: iampolicymanagementv1.V2PolicyResource{Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+				attribute("accountId", "stringEquals", "acc2"),
+			}},
+		},
+		"bucket prefix": {
+			resource: iampolicymanagementv1.V2PolicyResource{Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+				attribute("serviceName", "stringEquals", "cloud-object-storage"),
+				attribute("resource", "stringMatch", "logs-*"),
+			}},
+			matches: true,
+		},
+		"resource group": {
+			resource: iampolicymanagementv1.V2PolicyResource{Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+				attribute("resourceGroupId", "stringEquals", "rg1"),
+			}},
+		},
+		"any of the instances": {
+			resource: iampolicymanagementv1.V2PolicyResource{Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+				attribute("serviceInstance", "stringEqualsAnyOf", []interface{}{"inst0", "inst1"}),
+			}},
+			matches: true,
+		},
+		"without resource group": {
+			resource: iampolicymanagementv1.V2PolicyResource{Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+				attribute("resourceGroupId", "stringExists", false),
+			}},
+			matches: true,
+		},
+		"access tag": {
+			resource: iampolicymanagementv1.V2PolicyResource{Tags: []iampolicymanagementv1.V2PolicyResourceTag{
+				{Key: core.StringPtr("env"), Operator: core.StringPtr("stringEquals"), Value: core.StringPtr("prod")},
+			}},
+			tags:    []string{"team:a", "env:prod"},
+			matches: true,
+		},
+		"missing access tag": {
+			resource: iampolicymanagementv1.V2PolicyResource{Tags: []iampolicymanagementv1.V2PolicyResourceTag{
+				{Key: core.StringPtr("env"), Operator: core.StringPtr("stringEquals"), Value: core.StringPtr("prod")},
+			}},
+			tags: []string{"env:dev"},
+		},
+	} {
+		if matches := accessCheckPolicyMatches(tc.resource, target, tc.tags); matches != tc.matches {
+			t.Errorf("Policy %s matches is %t, expected %t", name, matches, tc.matches)
+		}
+	}
+}
+
+func TestUnitAccessCheckActionIncluded(t *testing.T) {
+	actions := []string{"cloud-object-storage.object.get", "cloud-object-storage.bucket.*"}
+	for action, included := range map[string]bool{
+		"cloud-object-storage.object.get":        true,
+		"cloud-object-storage.object.put":        false,
+		"cloud-object-storage.bucket.get_policy": true,
+	} {
+		if accessCheckActionIncluded(actions, action) != included {
+			t.Errorf("Action %s included is %t, expected %t", action, !included, included)
+		}
+	}
+}
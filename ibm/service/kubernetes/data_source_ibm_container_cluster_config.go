@@ -4,8 +4,14 @@
 package kubernetes
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -14,8 +20,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
+	yaml "gopkg.in/yaml.v3"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -93,6 +101,19 @@ func DataSourceIBMContainerClusterConfig() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"in_memory": {
+				Description:   "If set to true the config is returned in kube_config and the structured attributes only, without writing any file",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"config_dir", "network"},
+			},
+			"kube_config": {
+				Description: "The kubernetes config yml content, with the certificates inlined. Set when in_memory is true",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 			"config_file_path": {
 				Description: "The absolute path to the kubernetes config yml file ",
 				Type:        schema.TypeString,
@@ -158,6 +179,42 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	network := d.Get("network").(bool)
 	endpointType := d.Get("endpoint_type").(string)
 
+	if d.Get("in_memory").(bool) {
+		targetEnv, err := getVpcClusterTargetHeader(d)
+		if err != nil {
+			return err
+		}
+		var config clusterConfigContent
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			var err error
+			config, err = fetchClusterConfig(csClient, name, admin, targetEnv, endpointType)
+			if err != nil {
+				log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+				if isClusterConfigRetryable(err) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if conns.IsResourceTimeoutError(err) {
+			config, err = fetchClusterConfig(csClient, name, admin, targetEnv, endpointType)
+		}
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error fetching the cluster config [%s]: %s", name, err)
+		}
+		d.SetId(name)
+		d.Set("kube_config", config.KubeConfig)
+		d.Set("admin_key", config.AdminKey)
+		d.Set("admin_certificate", config.Admin)
+		d.Set("ca_certificate", config.ClusterCACertificate)
+		d.Set("host", config.Host)
+		d.Set("token", config.Token)
+		d.Set("config_file_path", "")
+		d.Set("calico_config_file_path", "")
+		return nil
+	}
+
 	clusterId := "Cluster_Config_" + name
 	conns.IbmMutexKV.Lock(clusterId)
 	defer conns.IbmMutexKV.Unlock(clusterId)
@@ -194,11 +251,7 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 				calicoConfigFilePath, clusterKeyDetails, err = csAPI.StoreConfigDetail(name, configDir, admin || true, network, targetEnv, endpointType)
 				if err != nil {
 					log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
-					if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
-						return resource.RetryableError(err)
-					}
-					if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
-						// Intermittent error resulting from synchronisation delay
+					if isClusterConfigRetryable(err) {
 						return resource.RetryableError(err)
 					}
 					return resource.NonRetryableError(err)
@@ -226,11 +279,7 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv, endpointType)
 				if err != nil {
 					log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
-					if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
-						return resource.RetryableError(err)
-					}
-					if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
-						// Intermittent error resulting from synchronisation delay
+					if isClusterConfigRetryable(err) {
 						return resource.RetryableError(err)
 					}
 					return resource.NonRetryableError(err)
@@ -256,3 +305,165 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+func isClusterConfigRetryable(err error) bool {
+	if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+		return true
+	}
+	// Intermittent error resulting from synchronisation delay
+	intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error())
+	return intermittentUserLookupFailure
+}
+
+// clusterConfigContent is the cluster config returned when in_memory is set.
+type clusterConfigContent struct {
+	v1.ClusterKeyInfo
+	KubeConfig string
+}
+
+// fetchClusterConfig downloads the cluster config archive into memory and
+// returns its content, with the certificate files referenced by the
+// kubeconfig inlined, so that nothing is written to the filesystem.
+func fetchClusterConfig(csClient v2.ContainerServiceAPI, name string, admin bool, target v2.ClusterTargetHeader, endpointType string) (clusterConfigContent, error) {
+	var config clusterConfigContent
+	client, ok := csClient.(interface {
+		Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+	})
+	if !ok {
+		return config, fmt.Errorf("the container service client does not support downloading the cluster config in memory")
+	}
+	clusterInfo, err := csClient.Clusters().GetCluster(name, target)
+	if err != nil {
+		return config, err
+	}
+
+	postBody := map[string]interface{}{
+		"cluster": name,
+		"format":  "zip",
+	}
+	if admin {
+		postBody["admin"] = true
+	}
+	if clusterInfo.Provider == "satellite" {
+		postBody["endpointType"] = "link"
+		postBody["admin"] = true
+	} else if endpointType != "" {
+		postBody["endpointType"] = endpointType
+	}
+	var archive bytes.Buffer
+	if _, err := client.Post("/v2/applyRBACAndGetKubeconfig", postBody, &archive, target.ToMap()); err != nil {
+		return config, err
+	}
+	zipReader, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	if err != nil {
+		return config, fmt.Errorf("unable to read the cluster config archive: %s", err)
+	}
+	files := map[string][]byte{}
+	var kubeConfig []byte
+	for _, f := range zipReader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return config, err
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return config, err
+		}
+		fileName := path.Base(f.Name)
+		files[fileName] = content
+		switch {
+		case fileName == "admin-key.pem":
+			config.AdminKey = string(content)
+		case fileName == "admin.pem":
+			config.Admin = string(content)
+		case strings.HasPrefix(fileName, "ca") && strings.HasSuffix(fileName, ".pem"):
+			config.ClusterCACertificate = string(content)
+		case strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml"):
+			kubeConfig = content
+		}
+	}
+	if kubeConfig == nil {
+		return config, fmt.Errorf("unable to locate the kube config in the cluster config archive")
+	}
+	if kubeConfig, err = inlineKubeConfigFiles(kubeConfig, files); err != nil {
+		return config, err
+	}
+
+	var yamlConfig v1.ConfigFile
+	if err := yaml.Unmarshal(kubeConfig, &yamlConfig); err != nil {
+		return config, fmt.Errorf("unable to parse the kube config: %s", err)
+	}
+	if len(yamlConfig.Clusters) != 0 {
+		config.Host = yamlConfig.Clusters[0].Cluster.Server
+	}
+	if len(yamlConfig.Users) != 0 {
+		config.Token = yamlConfig.Users[0].User.AuthProvider.Config.IDToken
+	}
+
+	// Openshift clusters need an Openshift token instead of the IAM one
+	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
+		ocClusters, ok := csClient.Clusters().(interface {
+			FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool, endpointType string) ([]byte, string, error)
+		})
+		if !ok {
+			return config, fmt.Errorf("the container service client does not support fetching an Openshift token")
+		}
+		kubeConfig, config.Host, err = ocClusters.FetchOCTokenForKubeConfig(kubeConfig, clusterInfo, clusterInfo.IsStagingSatelliteCluster(), endpointType)
+		if err != nil {
+			return config, err
+		}
+		var openshiftConfig v1.ConfigFileOpenshift
+		if err := yaml.Unmarshal(kubeConfig, &openshiftConfig); err != nil {
+			return config, fmt.Errorf("unable to parse the kube config: %s", err)
+		}
+		for _, usr := range openshiftConfig.Users {
+			if strings.HasPrefix(usr.Name, "IAM") {
+				config.Token = usr.User.Token
+			}
+		}
+		config.ClusterCACertificate = ""
+	}
+	config.KubeConfig = string(kubeConfig)
+	return config, nil
+}
+
+// inlineKubeConfigFiles replaces the certificate-authority, client-certificate
+// and client-key file references of a kubeconfig by their base64 encoded
+// -data counterparts.
+func inlineKubeConfigFiles(kubeConfig []byte, files map[string][]byte) ([]byte, error) {
+	var cfg map[string]interface{}
+	if err := yaml.Unmarshal(kubeConfig, &cfg); err != nil {
+		return nil, fmt.Errorf("unable to parse the kube config: %s", err)
+	}
+	inline := func(entries interface{}, section string, keys ...string) error {
+		list, _ := entries.([]interface{})
+		for _, entry := range list {
+			e, _ := entry.(map[string]interface{})
+			m, _ := e[section].(map[string]interface{})
+			for _, key := range keys {
+				fileName, ok := m[key].(string)
+				if !ok {
+					continue
+				}
+				content, ok := files[path.Base(fileName)]
+				if !ok {
+					return fmt.Errorf("unable to locate %s in the cluster config archive", fileName)
+				}
+				delete(m, key)
+				m[key+"-data"] = base64.StdEncoding.EncodeToString(content)
+			}
+		}
+		return nil
+	}
+	if err := inline(cfg["clusters"], "cluster", "certificate-authority"); err != nil {
+		return nil, err
+	}
+	if err := inline(cfg["users"], "user", "client-certificate", "client-key"); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(cfg)
+}
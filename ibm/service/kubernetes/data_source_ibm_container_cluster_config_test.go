@@ -4,14 +4,22 @@
 package kubernetes_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
 	"fmt"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -20,7 +28,7 @@ func TestAccIBMContainer_ClusterConfigDataSourceBasic(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error fetching homedir: %s", err)
 	}
-	clusterName := fmt.Sprintf("tf-cluster-config-%d", acctest.RandIntRange(10, 100))
+	clusterName := fmt.Sprintf("tf-cluster-config-%d", acc.RandIntRange(t, 10, 100))
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acc.TestAccPreCheck(t) },
 		Providers: acc.TestAccProviders,
@@ -37,12 +45,117 @@ func TestAccIBMContainer_ClusterConfigDataSourceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainer_ClusterConfigDataSourceInMemory(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-cluster-config-%d", acc.RandIntRange(t, 10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterDataSourceInMemoryConfig(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "kube_config"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "host"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "config_file_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitIBMContainer_ClusterConfigDataSourceInMemory(t *testing.T) {
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for name, content := range map[string]string{
+		"kubeConfig/kube-config-test.yaml": `apiVersion: v1
+clusters:
+- name: test-cluster/c0000
+  cluster:
+    certificate-authority: ca-test-cluster.pem
+    server: https://c0000.us-south.containers.cloud.ibm.com:30000
+users:
+- name: test-cluster/c0000/admin
+  user:
+    client-certificate: admin.pem
+    client-key: admin-key.pem
+    auth-provider:
+      name: oidc
+      config:
+        id-token: test-token
+`,
+		"kubeConfig/ca-test-cluster.pem": "test-ca",
+		"kubeConfig/admin.pem":           "test-admin-certificate",
+		"kubeConfig/admin-key.pem":       "test-admin-key",
+	} {
+		w, _ := zw.Create(name)
+		w.Write([]byte(content))
+	}
+	zw.Close()
+
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "GET", Path: "/v2/getCluster", Query: map[string]string{"cluster": "test-cluster"}, Body: []byte(`{"id":"c0000","name":"test-cluster","type":"kubernetes","provider":"vpc-gen2"}`)},
+		unittest.Fixture{Method: "POST", Path: "/v2/applyRBACAndGetKubeconfig", Headers: map[string]string{"Content-Type": "application/zip"}, Body: archive.Bytes()},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_CS_API_ENDPOINT": ""})
+	unittest.UseMockCredentials(t)
+	// The container service client also requires a refresh token.
+	t.Setenv("IC_IAM_REFRESH_TOKEN", "mock-refresh-token")
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	ds := p.DataSourcesMap["ibm_container_cluster_config"]
+	d := ds.TestResourceData()
+	d.Set("cluster_name_id", "test-cluster")
+	d.Set("admin", true)
+	d.Set("in_memory", true)
+	if diags := ds.ReadContext(context.Background(), d, p.Meta()); diags.HasError() {
+		t.Fatalf("Read returned an error: %v", diags)
+	}
+
+	for k, v := range map[string]string{
+		"host":              "https://c0000.us-south.containers.cloud.ibm.com:30000",
+		"token":             "test-token",
+		"ca_certificate":    "test-ca",
+		"admin_certificate": "test-admin-certificate",
+		"admin_key":         "test-admin-key",
+		"config_file_path":  "",
+	} {
+		if got := d.Get(k).(string); got != v {
+			t.Errorf("Read set %s to %q, expected %q", k, got, v)
+		}
+	}
+	kubeConfig := d.Get("kube_config").(string)
+	for _, expected := range []string{
+		"certificate-authority-data: " + base64.StdEncoding.EncodeToString([]byte("test-ca")),
+		"client-certificate-data: " + base64.StdEncoding.EncodeToString([]byte("test-admin-certificate")),
+		"client-key-data: " + base64.StdEncoding.EncodeToString([]byte("test-admin-key")),
+		"id-token: test-token",
+	} {
+		if !strings.Contains(kubeConfig, expected) {
+			t.Errorf("kube_config does not contain %q:\n%s", expected, kubeConfig)
+		}
+	}
+	if strings.Contains(kubeConfig, ".pem") {
+		t.Errorf("kube_config references certificate files:\n%s", kubeConfig)
+	}
+	if files, _ := os.ReadDir(home); len(files) != 0 {
+		t.Errorf("Read wrote %d files to the home directory", len(files))
+	}
+}
+
 func TestAccIBMContainer_ClusterConfigDataSourceVpcBasic(t *testing.T) {
 	homeDir, err := homedir.Dir()
 	if err != nil {
 		t.Fatalf("Error fetching homedir: %s", err)
 	}
-	clusterName := fmt.Sprintf("tf-cluster-config-%d", acctest.RandIntRange(10, 100))
+	clusterName := fmt.Sprintf("tf-cluster-config-%d", acc.RandIntRange(t, 10, 100))
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acc.TestAccPreCheck(t) },
 		Providers: acc.TestAccProviders,
@@ -64,7 +177,7 @@ func TestAccIBMContainer_ClusterConfigCalicoDataSourceBasic(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error fetching homedir: %s", err)
 	}
-	clusterName := fmt.Sprintf("tf-cluster-config-%d", acctest.RandIntRange(10, 100))
+	clusterName := fmt.Sprintf("tf-cluster-config-%d", acc.RandIntRange(t, 10, 100))
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acc.TestAccPreCheck(t) },
 		Providers: acc.TestAccProviders,
@@ -141,3 +254,25 @@ data "ibm_container_cluster_config" "testacc_ds_cluster" {
   endpoint_type   = "private"
 }`, clustername, acc.Datacenter, acc.MachineType, acc.PublicVlanID, acc.PrivateVlanID)
 }
+
+func testAccCheckIBMContainerClusterDataSourceInMemoryConfig(clustername string) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_cluster" "testacc_cluster" {
+		name              = "%[1]s"
+		vpc_id            = "%[2]s"
+		flavor            = "bx2.4x16"
+		worker_count      = 1
+		resource_group_id = "%[3]s"
+		zones {
+			subnet_id = "%[4]s"
+			name      = "us-south-1"
+		}
+		wait_till = "Normal"
+	}
+
+data "ibm_container_cluster_config" "testacc_ds_cluster" {
+  cluster_name_id = ibm_container_vpc_cluster.testacc_cluster.id
+  admin           = true
+  in_memory       = true
+}`, clustername, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID)
+}
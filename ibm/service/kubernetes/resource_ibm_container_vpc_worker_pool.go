@@ -35,6 +35,7 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -162,6 +163,15 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Description: "The operating system of the workers in the worker pool.",
 			},
 
+			"update_all_workers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, replaces the outdated workers of the worker pool on the apply which sets it to true, and on each apply which changes the operating system while it is true",
+			},
+
+			"update_strategy": workerUpdateStrategySchema(),
+
 			"secondary_storage": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -543,6 +553,23 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	updateAllWorkers := d.Get("update_all_workers").(bool)
+	if updateAllWorkers && (d.HasChange("operating_system") || d.HasChange("update_all_workers")) {
+		targetEnv, err := getVpcClusterTargetHeader(d)
+		if err != nil {
+			return err
+		}
+		updater, err := newVpcWorkerUpdater(d, meta, clusterNameOrID, workerPoolName, true, targetEnv)
+		if err != nil {
+			return err
+		}
+		if err := updater.Update(); err != nil {
+			// The next apply resumes the update of the workers.
+			d.Set("update_all_workers", false)
+			return err
+		}
+	}
+
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
@@ -0,0 +1,439 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	workerUpdateScopePool = "pool"
+	workerUpdateScopeZone = "zone"
+)
+
+// workerUpdateStrategy is how the outdated workers of a cluster or a worker
+// pool are replaced.
+type workerUpdateStrategy struct {
+	// maxUnavailable is the number of workers replaced at the same time in
+	// each pool or zone given by scope, or in the cluster if scope is empty.
+	maxUnavailable int
+	scope          string
+	// zoneByZone replaces all the workers of a zone before moving to the
+	// next one, in zoneOrder and then in alphabetical order.
+	zoneByZone    bool
+	zoneOrder     []string
+	healthCheck   bool
+	stopOnFailure bool
+}
+
+func workerUpdateStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "How the workers are replaced when they are updated",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of workers replaced at the same time in each pool or zone",
+				},
+				"max_unavailable_scope": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      workerUpdateScopePool,
+					ValidateFunc: validation.StringInSlice([]string{workerUpdateScopePool, workerUpdateScopeZone}, false),
+					Description:  "Whether max_unavailable applies to each worker pool or to each zone",
+				},
+				"zone_by_zone": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Replace all the workers of a zone before moving to the next zone",
+				},
+				"zone_order": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The order in which the zones are updated, the zones not listed are updated last in alphabetical order",
+				},
+				"health_check": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Wait for the new workers to be in normal state before replacing the next workers",
+				},
+				"stop_on_failure": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Stop the update at the first worker which fails to be replaced",
+				},
+			},
+		},
+	}
+}
+
+// expandWorkerUpdateStrategy returns the update_strategy of d. Without it,
+// the workers are replaced one at a time in the cluster, zone by zone as with
+// the default of zone_by_zone.
+func expandWorkerUpdateStrategy(d *schema.ResourceData) workerUpdateStrategy {
+	strategy := workerUpdateStrategy{
+		maxUnavailable: 1,
+		zoneByZone:     true,
+		healthCheck:    true,
+		stopOnFailure:  true,
+	}
+	l, ok := d.GetOk("update_strategy")
+	if !ok || len(l.([]interface{})) == 0 || l.([]interface{})[0] == nil {
+		return strategy
+	}
+	m := l.([]interface{})[0].(map[string]interface{})
+	strategy.maxUnavailable = m["max_unavailable"].(int)
+	strategy.scope = m["max_unavailable_scope"].(string)
+	strategy.zoneByZone = m["zone_by_zone"].(bool)
+	for _, zone := range m["zone_order"].([]interface{}) {
+		if zone != nil {
+			strategy.zoneOrder = append(strategy.zoneOrder, zone.(string))
+		}
+	}
+	strategy.healthCheck = m["health_check"].(bool)
+	strategy.stopOnFailure = m["stop_on_failure"].(bool)
+	return strategy
+}
+
+// planWorkerUpdates splits the workers to replace into batches which are
+// replaced one after the other.
+func planWorkerUpdates(workers []v2.Worker, strategy workerUpdateStrategy) [][]v2.Worker {
+	maxUnavailable := strategy.maxUnavailable
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+
+	stages := [][]v2.Worker{workers}
+	if strategy.zoneByZone {
+		rank := make(map[string]int, len(strategy.zoneOrder))
+		for i, zone := range strategy.zoneOrder {
+			if _, ok := rank[zone]; !ok {
+				rank[zone] = i
+			}
+		}
+		byZone := map[string][]v2.Worker{}
+		var zones []string
+		for _, worker := range workers {
+			if _, ok := byZone[worker.Location]; !ok {
+				zones = append(zones, worker.Location)
+			}
+			byZone[worker.Location] = append(byZone[worker.Location], worker)
+		}
+		sort.SliceStable(zones, func(i, j int) bool {
+			ri, iok := rank[zones[i]]
+			rj, jok := rank[zones[j]]
+			if iok != jok {
+				return iok
+			}
+			if iok {
+				return ri < rj
+			}
+			return zones[i] < zones[j]
+		})
+		stages = stages[:0]
+		for _, zone := range zones {
+			stages = append(stages, byZone[zone])
+		}
+	}
+
+	var batches [][]v2.Worker
+	for _, remaining := range stages {
+		for len(remaining) > 0 {
+			var batch, next []v2.Worker
+			unavailable := map[string]int{}
+			for _, worker := range remaining {
+				key := ""
+				switch strategy.scope {
+				case workerUpdateScopePool:
+					key = worker.PoolID
+				case workerUpdateScopeZone:
+					key = worker.Location
+				}
+				if unavailable[key] < maxUnavailable {
+					unavailable[key]++
+					batch = append(batch, worker)
+				} else {
+					next = append(next, worker)
+				}
+			}
+			batches = append(batches, batch)
+			remaining = next
+		}
+	}
+	return batches
+}
+
+// vpcWorkerUpdater replaces the outdated workers of a cluster, or of one of
+// its worker pools, following a workerUpdateStrategy.
+type vpcWorkerUpdater struct {
+	client     v2.ContainerServiceAPI
+	clusterID  string
+	workerPool string
+	target     v2.ClusterTargetHeader
+	strategy   workerUpdateStrategy
+	// wait is false to replace all the workers at once without waiting.
+	wait    bool
+	timeout time.Duration
+}
+
+func (u *vpcWorkerUpdater) listWorkers() ([]v2.Worker, error) {
+	if u.workerPool != "" {
+		return u.client.Workers().ListByWorkerPool(u.clusterID, u.workerPool, false, u.target)
+	}
+	return u.client.Workers().ListWorkers(u.clusterID, false, u.target)
+}
+
+// outdatedWorkers returns the workers whose kube version or operating system
+// differs from their target.
+func (u *vpcWorkerUpdater) outdatedWorkers(workers []v2.Worker) ([]v2.Worker, error) {
+	operatingSystems := map[string]string{}
+	var outdated []v2.Worker
+	for _, worker := range workers {
+		operatingSystem, ok := operatingSystems[worker.PoolID]
+		if !ok {
+			workerPool, err := u.client.WorkerPools().GetWorkerPool(u.clusterID, worker.PoolID, u.target)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
+			}
+			operatingSystem = workerPool.OperatingSystem
+			operatingSystems[worker.PoolID] = operatingSystem
+		}
+		// check if change is present in MAJOR.MINOR version or in PATCH version
+		if worker.KubeVersion.Actual != worker.KubeVersion.Target || worker.LifeCycle.ActualOperatingSystem != operatingSystem {
+			outdated = append(outdated, worker)
+		}
+	}
+	return outdated, nil
+}
+
+// Update replaces the outdated workers. The workers which are already up to
+// date are skipped, so that a failed update resumes where it stopped when it
+// is run again.
+func (u *vpcWorkerUpdater) Update() error {
+	workers, err := u.listWorkers()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	outdated, err := u.outdatedWorkers(workers)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(workers))
+	for _, worker := range workers {
+		known[worker.ID] = true
+	}
+
+	if !u.wait {
+		for _, worker := range outdated {
+			if err := u.replace(worker); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// The workers replaced by a previous update which are not deployed yet
+	// count as unavailable, so they are waited for first.
+	if u.strategy.healthCheck {
+		isOutdated := make(map[string]bool, len(outdated))
+		for _, worker := range outdated {
+			isOutdated[worker.ID] = true
+		}
+		for _, worker := range workers {
+			if !isOutdated[worker.ID] && isWorkerDeploying(worker) {
+				log.Printf("[INFO] Waiting for worker %s of cluster %s, replaced by a previous update", worker.ID, u.clusterID)
+				if err := u.waitForNormal(worker.ID); err != nil {
+					return fmt.Errorf("[ERROR] Error waiting for worker %s of cluster %s to be normal: %s", worker.ID, u.clusterID, err)
+				}
+			}
+		}
+	}
+
+	batches := planWorkerUpdates(outdated, u.strategy)
+	var failures []string
+	for n, batch := range batches {
+		ids := make([]string, 0, len(batch))
+		for _, worker := range batch {
+			ids = append(ids, worker.ID)
+		}
+		log.Printf("[INFO] Replacing workers %s of cluster %s (batch %d of %d)", strings.Join(ids, ", "), u.clusterID, n+1, len(batches))
+		if err := u.replaceBatch(batch, len(workers), known); err != nil {
+			if u.strategy.stopOnFailure {
+				return err
+			}
+			log.Printf("[WARN] %s", err)
+			failures = append(failures, err.Error())
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("[ERROR] %d of %d batches of workers of cluster %s failed to be replaced:\n%s", len(failures), len(batches), u.clusterID, strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// isWorkerDeploying returns whether worker is still being provisioned or
+// deployed, as the replacement of a worker is until it is ready. The workers
+// which are deployed but not normal are not waited for, as they are not
+// made unavailable by an update.
+func isWorkerDeploying(worker v2.Worker) bool {
+	switch worker.LifeCycle.ActualState {
+	case "provision_pending", workerProvisioning, clusterDeploying:
+		return true
+	}
+	return false
+}
+
+func (u *vpcWorkerUpdater) replace(worker v2.Worker) error {
+	_, err := u.client.Workers().ReplaceWokerNode(u.clusterID, worker.ID, u.target)
+	// As API returns http response 204 NO CONTENT, error raised will be exempted.
+	if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+		return fmt.Errorf("[ERROR] Error replacing the worker node %s from the cluster: %s", worker.ID, err)
+	}
+	return nil
+}
+
+// replaceBatch replaces the workers of batch and waits for their
+// replacements, which are added to known.
+func (u *vpcWorkerUpdater) replaceBatch(batch []v2.Worker, workersCount int, known map[string]bool) error {
+	for _, worker := range batch {
+		if err := u.replace(worker); err != nil {
+			return err
+		}
+	}
+	for _, worker := range batch {
+		if err := u.waitForDeleted(worker.ID); err != nil {
+			return fmt.Errorf("[ERROR] Worker node - %s is failed to replace: %s", worker.ID, err)
+		}
+	}
+
+	newWorkers, err := u.waitForNewWorkers(workersCount, len(batch), known)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Failed to spawn new worker nodes: %s", err)
+	}
+	for _, id := range newWorkers {
+		known[id] = true
+	}
+	if !u.strategy.healthCheck {
+		return nil
+	}
+	for _, id := range newWorkers {
+		if err := u.waitForNormal(id); err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for cluster (%s) worker node %s kube version to be updated: %s", u.clusterID, id, err)
+		}
+	}
+	return nil
+}
+
+func (u *vpcWorkerUpdater) waitForDeleted(workerID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workerDeletePending},
+		Target:  []string{workerDeleteState},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := u.client.Workers().Get(u.clusterID, workerID, u.target)
+			if err != nil {
+				return worker, workerDeletePending, nil
+			}
+			if worker.LifeCycle.ActualState == "deleted" {
+				return worker, workerDeleteState, nil
+			}
+			return worker, workerDeletePending, nil
+		},
+		Timeout:      u.timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// waitForNewWorkers waits for the workers to be back to workersCount, and
+// returns the ids of the count workers which are not known.
+func (u *vpcWorkerUpdater) waitForNewWorkers(workersCount, count int, known map[string]bool) ([]string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"created"},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := u.listWorkers()
+			if err != nil {
+				return workers, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
+			}
+			var newWorkers []string
+			for _, worker := range workers {
+				if !known[worker.ID] {
+					newWorkers = append(newWorkers, worker.ID)
+				}
+			}
+			if len(workers) >= workersCount && len(newWorkers) >= count {
+				log.Printf("[DEBUG] Found the new worker nodes %s of cluster %s", strings.Join(newWorkers, ", "), u.clusterID)
+				return newWorkers, "created", nil
+			}
+			return newWorkers, "creating", nil
+		},
+		Timeout:      u.timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	newWorkers, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	return newWorkers.([]string), nil
+}
+
+// waitForNormal is the health check of a worker, which waits for it to be
+// in normal state.
+func (u *vpcWorkerUpdater) waitForNormal(workerID string) error {
+	log.Printf("Waiting for worker (%s) version to be updated.", workerID)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"retry", versionUpdating},
+		Target:                    []string{workerNormal},
+		Refresh:                   vpcClusterWorkersVersionRefreshFunc(u.client.Workers(), workerID, u.clusterID, u.target),
+		Timeout:                   u.timeout,
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// newVpcWorkerUpdater returns the updater of the workers of clusterID, or of
+// its workerPool if not empty, with the update_strategy of d.
+func newVpcWorkerUpdater(d *schema.ResourceData, meta interface{}, clusterID, workerPool string, wait bool, target v2.ClusterTargetHeader) (*vpcWorkerUpdater, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	return &vpcWorkerUpdater{
+		client:     csClient,
+		clusterID:  clusterID,
+		workerPool: workerPool,
+		target:     target,
+		strategy:   expandWorkerUpdateStrategy(d),
+		wait:       wait,
+		timeout:    d.Timeout(schema.TimeoutUpdate),
+	}, nil
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPlanWorkerUpdates(t *testing.T) {
+	var workers []v2.Worker
+	for _, w := range []string{"a1:default:us-south-1", "a2:default:us-south-1", "b1:default:us-south-2", "c1:default:us-south-3", "a3:gpu:us-south-1", "b2:gpu:us-south-2"} {
+		parts := strings.Split(w, ":")
+		workers = append(workers, v2.Worker{ID: parts[0], PoolID: parts[1], Location: parts[2]})
+	}
+
+	for _, tc := range []struct {
+		name     string
+		strategy workerUpdateStrategy
+		expected string
+	}{
+		{
+			name:     "one worker at a time",
+			strategy: workerUpdateStrategy{maxUnavailable: 1},
+			expected: "a1 | a2 | b1 | c1 | a3 | b2",
+		},
+		{
+			name:     "per pool",
+			strategy: workerUpdateStrategy{maxUnavailable: 2, scope: workerUpdateScopePool},
+			expected: "a1 a2 a3 b2 | b1 c1",
+		},
+		{
+			name:     "per zone",
+			strategy: workerUpdateStrategy{maxUnavailable: 1, scope: workerUpdateScopeZone},
+			expected: "a1 b1 c1 | a2 b2 | a3",
+		},
+		{
+			name:     "zone by zone",
+			strategy: workerUpdateStrategy{maxUnavailable: 1, scope: workerUpdateScopePool, zoneByZone: true},
+			expected: "a1 a3 | a2 | b1 b2 | c1",
+		},
+		{
+			name:     "zone order",
+			strategy: workerUpdateStrategy{maxUnavailable: 2, scope: workerUpdateScopeZone, zoneByZone: true, zoneOrder: []string{"us-south-3", "us-south-2"}},
+			expected: "c1 | b1 b2 | a1 a2 | a3",
+		},
+	} {
+		var batches []string
+		for _, batch := range planWorkerUpdates(workers, tc.strategy) {
+			var ids []string
+			for _, worker := range batch {
+				ids = append(ids, worker.ID)
+			}
+			batches = append(batches, strings.Join(ids, " "))
+		}
+		if got := strings.Join(batches, " | "); got != tc.expected {
+			t.Errorf("%s: planWorkerUpdates returned %q, expected %q", tc.name, got, tc.expected)
+		}
+	}
+}
+
+func TestExpandWorkerUpdateStrategy(t *testing.T) {
+	s := map[string]*schema.Schema{"update_strategy": workerUpdateStrategySchema()}
+	for _, tc := range []struct {
+		name     string
+		raw      map[string]interface{}
+		expected workerUpdateStrategy
+	}{
+		{
+			name:     "no block",
+			raw:      map[string]interface{}{},
+			expected: workerUpdateStrategy{maxUnavailable: 1, zoneByZone: true, healthCheck: true, stopOnFailure: true},
+		},
+		{
+			name: "block",
+			raw: map[string]interface{}{"update_strategy": []interface{}{map[string]interface{}{
+				"max_unavailable": 2,
+				"zone_by_zone":    false,
+			}}},
+			expected: workerUpdateStrategy{maxUnavailable: 2, scope: workerUpdateScopePool, healthCheck: true, stopOnFailure: true},
+		},
+	} {
+		d := schema.TestResourceDataRaw(t, s, tc.raw)
+		if got := expandWorkerUpdateStrategy(d); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: expandWorkerUpdateStrategy returned %+v, expected %+v", tc.name, got, tc.expected)
+		}
+	}
+}
+
+func TestIsWorkerDeploying(t *testing.T) {
+	for state, expected := range map[string]bool{
+		"provision_pending": true,
+		"provisioning":      true,
+		"deploying":         true,
+		"deployed":          false,
+		"deleting":          false,
+	} {
+		worker := v2.Worker{LifeCycle: v2.WorkerLifeCycle{ActualState: state}, Health: v2.HealthStatus{State: "critical"}}
+		if got := isWorkerDeploying(worker); got != expected {
+			t.Errorf("isWorkerDeploying of a %s worker returned %v, expected %v", state, got, expected)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIJob() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIJobRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_JobID: {
+				Description:  "ID of the job.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_CreationDate: {
+				Computed:    true,
+				Description: "The date and time the job was created.",
+				Type:        schema.TypeString,
+			},
+			Attr_Operation: {
+				Computed:    true,
+				Description: "The operation of the job.",
+				Elem:        jobOperationSchema(),
+				Type:        schema.TypeList,
+			},
+			Attr_Status: {
+				Computed:    true,
+				Description: "The status of the job.",
+				Elem:        jobStatusSchema(),
+				Type:        schema.TypeList,
+			},
+		},
+	}
+}
+
+func jobOperationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			Attr_Action: {
+				Computed:    true,
+				Description: "The action of the operation, for example vmCapture or imageExport.",
+				Type:        schema.TypeString,
+			},
+			Attr_ID: {
+				Computed:    true,
+				Description: "The ID of the object the operation acts on.",
+				Type:        schema.TypeString,
+			},
+			Attr_Target: {
+				Computed:    true,
+				Description: "The target of the operation.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func jobStatusSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			Attr_Message: {
+				Computed:    true,
+				Description: "The message detailing the state of the job, for example why it failed.",
+				Type:        schema.TypeString,
+			},
+			Attr_Progress: {
+				Computed:    true,
+				Description: "The progress of the job.",
+				Type:        schema.TypeString,
+			},
+			Attr_State: {
+				Computed:    true,
+				Description: "The state of the job.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	job, err := client.Get(d.Get(Arg_JobID).(string))
+	if err != nil {
+		log.Printf("[DEBUG] get job failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *job.ID))
+	d.Set(Attr_CreationDate, job.CreateTimestamp.String())
+	d.Set(Attr_Operation, flattenJobOperation(job.Operation))
+	d.Set(Attr_Status, flattenJobStatus(job.Status))
+
+	return nil
+}
+
+func flattenJobOperation(operation *models.Operation) []map[string]interface{} {
+	if operation == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		Attr_Action: flex.StringValue(operation.Action),
+		Attr_ID:     flex.StringValue(operation.ID),
+		Attr_Target: flex.StringValue(operation.Target),
+	}}
+}
+
+func flattenJobStatus(status *models.Status) []map[string]interface{} {
+	if status == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		Attr_Message:  status.Message,
+		Attr_Progress: flex.StringValue(status.Progress),
+		Attr_State:    flex.StringValue(status.State),
+	}}
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIJobDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIJobDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_job.job", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_job.job", "status.0.state"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_job.job", "operation.0.action"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIJobDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_jobs" "jobs" {
+			pi_cloud_instance_id = "%[1]s"
+		}
+		data "ibm_pi_job" "job" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_job_id            = data.ibm_pi_jobs.jobs.jobs[0].id
+		}`, acc.Pi_cloud_instance_id)
+}
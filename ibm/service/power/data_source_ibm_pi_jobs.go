@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Datasource to list the jobs in a power instance
+func DataSourceIBMPIJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIJobsRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_Jobs: {
+				Computed:    true,
+				Description: "List of all the jobs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CreationDate: {
+							Computed:    true,
+							Description: "The date and time the job was created.",
+							Type:        schema.TypeString,
+						},
+						Attr_ID: {
+							Computed:    true,
+							Description: "The ID of the job.",
+							Type:        schema.TypeString,
+						},
+						Attr_Operation: {
+							Computed:    true,
+							Description: "The operation of the job.",
+							Elem:        jobOperationSchema(),
+							Type:        schema.TypeList,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the job.",
+							Elem:        jobStatusSchema(),
+							Type:        schema.TypeList,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	jobs, err := client.GetAll()
+	if err != nil {
+		log.Printf("[DEBUG] get all jobs failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	jobList := make([]map[string]interface{}, 0, len(jobs.Jobs))
+	for _, job := range jobs.Jobs {
+		jobList = append(jobList, map[string]interface{}{
+			Attr_CreationDate: job.CreateTimestamp.String(),
+			Attr_ID:           *job.ID,
+			Attr_Operation:    flattenJobOperation(job.Operation),
+			Attr_Status:       flattenJobStatus(job.Status),
+		})
+	}
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+	d.Set(Attr_Jobs, jobList)
+
+	return nil
+}
@@ -73,11 +73,13 @@ const (
 	Arg_InstanceName                         = "pi_instance_name"
 	Arg_IPAddress                            = "pi_ip_address"
 	Arg_IPAddressRange                       = "pi_ipaddress_range"
+	Arg_JobID                                = "pi_job_id"
 	Arg_Key                                  = "pi_ssh_key"
 	Arg_KeyName                              = "pi_key_name"
 	Arg_KeyPairName                          = "pi_key_pair_name"
 	Arg_LanguageCode                         = "pi_language_code"
 	Arg_LicenseRepositoryCapacity            = "pi_license_repository_capacity"
+	Arg_MaintenanceWindow                    = "pi_maintenance_window"
 	Arg_Memory                               = "pi_memory"
 	Arg_Name                                 = "pi_name"
 	Arg_Network                              = "pi_network"
@@ -243,6 +245,7 @@ const (
 	Attr_DiskType                                    = "disk_type"
 	Attr_DisplayName                                 = "display_name"
 	Attr_DNS                                         = "dns"
+	Attr_Duration                                    = "duration"
 	Attr_Enabled                                     = "enabled"
 	Attr_Endianness                                  = "endianness"
 	Attr_ExternalIP                                  = "external_ip"
@@ -296,6 +299,9 @@ const (
 	Attr_IPaddress                                   = "ipaddress"
 	Attr_IPOctet                                     = "ipoctet"
 	Attr_IsActive                                    = "is_active"
+	Attr_JobID                                       = "job_id"
+	Attr_Jobs                                        = "jobs"
+	Attr_JobStatus                                   = "job_status"
 	Attr_Jumbo                                       = "jumbo"
 	Attr_Key                                         = "key"
 	Attr_KeyCreationDate                             = "creation_date"
@@ -359,6 +365,7 @@ const (
 	Attr_OnboardingID                                = "onboarding_id"
 	Attr_Onboardings                                 = "onboardings"
 	Attr_OperatingSystem                             = "operating_system"
+	Attr_Operation                                   = "operation"
 	Attr_OSType                                      = "os_type"
 	Attr_PeerID                                      = "peer_id"
 	Attr_PercentComplete                             = "percent_complete"
@@ -406,6 +413,7 @@ const (
 	Attr_ResultsVolumeOnboardingFailures             = "results_volume_onboarding_failures"
 	Attr_Rules                                       = "rules"
 	Attr_SAPS                                        = "saps"
+	Attr_Schedule                                    = "schedule"
 	Attr_Secondaries                                 = "secondaries"
 	Attr_Serial                                      = "serial"
 	Attr_ServerName                                  = "server_name"
@@ -432,6 +440,7 @@ const (
 	Attr_SharedProcessorPools                        = "shared_processor_pools"
 	Attr_SharedProcessorPoolStatus                   = "status"
 	Attr_SharedProcessorPoolStatusDetail             = "status_detail"
+	Attr_ShutdownRequired                            = "shutdown_required"
 	Attr_Size                                        = "size"
 	Attr_SnapshotID                                  = "snapshot_id"
 	Attr_Source                                      = "source"
@@ -475,6 +484,7 @@ const (
 	Attr_TCPFlags                                    = "tcp_flags"
 	Attr_TenantID                                    = "tenant_id"
 	Attr_TenantName                                  = "tenant_name"
+	Attr_TimeZone                                    = "time_zone"
 	Attr_TotalCapacity                               = "total_capacity"
 	Attr_TotalCore                                   = "total_core"
 	Attr_TotalInstances                              = "total_instances"
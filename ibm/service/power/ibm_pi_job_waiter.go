@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	pierrors "github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// jobPendingStates are the states of a job which has not ended yet.
+var jobPendingStates = []string{State_Queued, State_ReadyForProcessing, State_inProgress, State_Running, State_Waiting}
+
+func isIBMPIJobPending(state string) bool {
+	for _, s := range jobPendingStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// waitForIBMPIJobCompleted waits for a job with the poll interval of the
+// resource type starting it.
+func waitForIBMPIJobCompleted(ctx context.Context, client *instance.IBMPIJobClient, jobID string, timeout time.Duration, interval waiter.Interval) (interface{}, error) {
+	return waitForIBMPIJob(ctx, client, jobID, timeout, interval, "", "")
+}
+
+// waitForIBMPIJob waits for a job to complete and logs its progress. The
+// message of a failed job is returned as a TerraformProblem of resourceType
+// and operation, and a *retry.TimeoutError is returned with the last state of
+// the job when timeout elapses first.
+func waitForIBMPIJob(ctx context.Context, client *instance.IBMPIJobClient, jobID string, timeout time.Duration, interval waiter.Interval, resourceType, operation string) (*models.Job, error) {
+	progress := ""
+	w := &waiter.Waiter{
+		Object:  "job " + jobID,
+		Pending: jobPendingStates,
+		Target:  []string{State_Completed, State_Failed},
+		Refresh: func() (interface{}, string, error) {
+			job, err := client.Get(jobID)
+			if err != nil {
+				log.Printf("[DEBUG] get job failed %v", err)
+				return nil, "", fmt.Errorf(pierrors.GetJobOperationFailed, jobID, err)
+			}
+			if job == nil || job.Status == nil || job.Status.State == nil {
+				log.Printf("[DEBUG] get job failed with empty response")
+				return nil, "", fmt.Errorf("failed to get job status for job id %s", jobID)
+			}
+			if job.Status.Progress != nil && *job.Status.Progress != progress {
+				progress = *job.Status.Progress
+				log.Printf("[INFO] Job %s%s is %s: %s", jobID, ibmPIJobOperation(job), *job.Status.State, progress)
+			}
+			return job, *job.Status.State, nil
+		},
+		Timeout:  timeout,
+		Interval: interval,
+		Key:      "power/job/" + jobID,
+	}
+	result, err := w.Wait(ctx)
+	job, _ := result.(*models.Job)
+	if err != nil {
+		return job, err
+	}
+	if *job.Status.State == State_Failed {
+		log.Printf("[DEBUG] job status failed with message: %v", job.Status.Message)
+		return job, flex.TerraformErrorf(errors.New(job.Status.Message),
+			fmt.Sprintf("job status failed for job id %s with message: %s", jobID, job.Status.Message), resourceType, operation)
+	}
+	return job, nil
+}
+
+// ibmPIJobOperation describes the operation of a job in the progress logs.
+func ibmPIJobOperation(job *models.Job) string {
+	if job.Operation == nil || job.Operation.Action == nil || job.Operation.ID == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (%s of %s)", *job.Operation.Action, *job.Operation.ID)
+}
+
+// waitForIBMPIResourceJob waits for the job in the job_id attribute of a
+// resource and records its state in job_status, which is left pending when
+// the job is still running after the timeout of the operation.
+func waitForIBMPIResourceJob(ctx context.Context, d *schema.ResourceData, meta interface{}, cloudInstanceID, resourceType, operation string) error {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if operation == "create" {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	jobID := d.Get(Attr_JobID).(string)
+	client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	job, err := waitForIBMPIJob(ctx, client, jobID, timeout, meta.(conns.ClientSession).PollInterval(resourceType), resourceType, operation)
+	if job != nil {
+		d.Set(Attr_JobStatus, *job.Status.State)
+	}
+	if isIBMPIJobTimeout(err) {
+		if !isIBMPIJobPending(d.Get(Attr_JobStatus).(string)) {
+			d.Set(Attr_JobStatus, State_Queued)
+		}
+		return fmt.Errorf("job %s is still %s after %s, the next apply resumes waiting for it: %w", jobID, d.Get(Attr_JobStatus), timeout, err)
+	}
+	return err
+}
+
+func isIBMPIJobTimeout(err error) bool {
+	var timeoutErr *retry.TimeoutError
+	return errors.As(err, &timeoutErr)
+}
+
+// ibmPIJobDiagnostics returns the diagnostics of a job error, the
+// TerraformProblem of a failed job carries its message.
+func ibmPIJobDiagnostics(err error) diag.Diagnostics {
+	var tfErr *flex.TerraformProblem
+	if errors.As(err, &tfErr) {
+		return tfErr.GetDiag()
+	}
+	return diag.FromErr(err)
+}
+
+// refreshIBMPIResourceJob refreshes the job_status of a resource whose job had
+// not ended, and reports whether the job is still running.
+func refreshIBMPIResourceJob(ctx context.Context, d *schema.ResourceData, meta interface{}, cloudInstanceID string) (bool, error) {
+	if !isIBMPIJobPending(d.Get(Attr_JobStatus).(string)) {
+		return false, nil
+	}
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return false, err
+	}
+	job, err := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID).Get(d.Get(Attr_JobID).(string))
+	if err != nil {
+		return false, err
+	}
+	if job.Status == nil || job.Status.State == nil {
+		return false, fmt.Errorf("failed to get job status for job id %s", d.Get(Attr_JobID))
+	}
+	d.Set(Attr_JobStatus, *job.Status.State)
+	return isIBMPIJobPending(*job.Status.State), nil
+}
+
+// resumeIBMPIJobCustomizeDiff plans an update of a resource whose job was
+// still running after the timeout of the last apply, to resume waiting for it.
+func resumeIBMPIJobCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() != "" && isIBMPIJobPending(diff.Get(Attr_JobStatus).(string)) {
+		return diff.SetNewComputed(Attr_JobStatus)
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maintenanceWindow is a recurring window, starting at the times matched by
+// a cron schedule and lasting for a duration, in which the disruptive changes
+// of an instance are allowed.
+type maintenanceWindow struct {
+	schedule string
+	// fields are the minutes, hours, days of the month, months and days of
+	// the week matched by the schedule.
+	fields   [5]cronField
+	duration time.Duration
+	location *time.Location
+}
+
+// cronField is the set of values matched by a field of a cron schedule.
+type cronField struct {
+	values uint64
+	any    bool
+}
+
+// cronFieldBounds are the bounds and names of the fields of a cron schedule.
+var cronFieldBounds = [5]struct {
+	min, max int
+	names    []string
+}{
+	{min: 0, max: 59},
+	{min: 0, max: 23},
+	{min: 1, max: 31},
+	{min: 1, max: 12, names: []string{"", "JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// maxMaintenanceWindowDuration bounds the duration of a maintenance window.
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// parseMaintenanceWindow parses a window starting at the times matched by the
+// cron schedule "minute hour day-of-month month day-of-week" in the IANA time
+// zone timeZone, for example "0 2 * * SAT" for 4h in UTC.
+func parseMaintenanceWindow(schedule, duration, timeZone string) (*maintenanceWindow, error) {
+	w := &maintenanceWindow{schedule: schedule}
+	parts := strings.Fields(schedule)
+	if len(parts) != len(w.fields) {
+		return nil, fmt.Errorf("schedule %q must have 5 fields: minute, hour, day of month, month and day of week", schedule)
+	}
+	for i, part := range parts {
+		field, err := parseCronField(part, i)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %s", schedule, err)
+		}
+		w.fields[i] = field
+	}
+	// Sunday is both 0 and 7.
+	if w.fields[4].values&(1<<7) != 0 {
+		w.fields[4].values |= 1
+	}
+
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration %q: %s", duration, err)
+	}
+	if d < time.Minute || d > maxMaintenanceWindowDuration {
+		return nil, fmt.Errorf("duration %q must be between 1m and %s", duration, maxMaintenanceWindowDuration)
+	}
+	w.duration = d
+
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	if w.location, err = time.LoadLocation(timeZone); err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %s", timeZone, err)
+	}
+	return w, nil
+}
+
+// parseCronField parses a comma separated list of *, values, ranges and
+// steps like */15 or 1-5/2.
+func parseCronField(s string, index int) (cronField, error) {
+	bounds := cronFieldBounds[index]
+	field := cronField{any: s == "*"}
+	for _, item := range strings.Split(s, ",") {
+		rangePart, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n <= 0 {
+				return field, fmt.Errorf("invalid step in %q", item)
+			}
+			rangePart, step = item[:i], n
+		}
+		low, high := bounds.min, bounds.max
+		if rangePart != "*" {
+			bound := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if low, err = parseCronValue(bound[0], index); err != nil {
+				return field, err
+			}
+			high = low
+			if len(bound) == 2 {
+				if high, err = parseCronValue(bound[1], index); err != nil {
+					return field, err
+				}
+			} else if step > 1 {
+				high = bounds.max
+			}
+			if high < low {
+				return field, fmt.Errorf("invalid range %q", rangePart)
+			}
+		}
+		for v := low; v <= high; v += step {
+			field.values |= 1 << uint(v)
+		}
+	}
+	return field, nil
+}
+
+func parseCronValue(s string, index int) (int, error) {
+	bounds := cronFieldBounds[index]
+	for v, name := range bounds.names {
+		if name != "" && strings.EqualFold(s, name) {
+			return v, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < bounds.min || v > bounds.max {
+		return 0, fmt.Errorf("invalid value %q, expected %d-%d", s, bounds.min, bounds.max)
+	}
+	return v, nil
+}
+
+func (f cronField) matches(v int) bool {
+	return f.values&(1<<uint(v)) != 0
+}
+
+// starts reports whether a window starts at the minute of t.
+func (w *maintenanceWindow) starts(t time.Time) bool {
+	t = t.In(w.location)
+	if !w.fields[0].matches(t.Minute()) || !w.fields[1].matches(t.Hour()) || !w.fields[3].matches(int(t.Month())) {
+		return false
+	}
+	dom, dow := w.fields[2], w.fields[4]
+	// As with cron, a day matches either field when both are restricted.
+	if !dom.any && !dow.any {
+		return dom.matches(t.Day()) || dow.matches(int(t.Weekday()))
+	}
+	return dom.matches(t.Day()) && dow.matches(int(t.Weekday()))
+}
+
+// contains reports whether t is in a window.
+func (w *maintenanceWindow) contains(t time.Time) bool {
+	t = t.Truncate(time.Minute)
+	for elapsed := time.Duration(0); elapsed < w.duration; elapsed += time.Minute {
+		if w.starts(t.Add(-elapsed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// next returns the start of the next window after t, or the zero time when
+// none starts within a year.
+func (w *maintenanceWindow) next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute)
+	for start := t.Add(time.Minute); start.Before(t.AddDate(1, 0, 1)); start = start.Add(time.Minute) {
+		if w.starts(start) {
+			return start.In(w.location)
+		}
+	}
+	return time.Time{}
+}
+
+func (w *maintenanceWindow) String() string {
+	return fmt.Sprintf("%q for %s (%s)", w.schedule, w.duration, w.location)
+}
+
+// maintenanceWindowFromList returns the window of a pi_maintenance_window
+// block, or nil when there is none.
+func maintenanceWindowFromList(v []interface{}) (*maintenanceWindow, error) {
+	if len(v) == 0 || v[0] == nil {
+		return nil, nil
+	}
+	m := v[0].(map[string]interface{})
+	return parseMaintenanceWindow(m[Attr_Schedule].(string), m[Attr_Duration].(string), m[Attr_TimeZone].(string))
+}
+
+// checkMaintenanceWindow returns an error when the arguments in changes stop
+// the instance outside of its maintenance window.
+func checkMaintenanceWindow(window *maintenanceWindow, changes []string, now time.Time) error {
+	if window == nil || len(changes) == 0 || window.contains(now) {
+		return nil
+	}
+	next := "no window starts within a year"
+	if start := window.next(now); !start.IsZero() {
+		next = "the next window starts at " + start.Format(time.RFC3339)
+	}
+	return fmt.Errorf("the change of %s stops the instance, which is only allowed in its %s %s; %s",
+		strings.Join(changes, ", "), Arg_MaintenanceWindow, window, next)
+}
+
+// resourceChange is the part of schema.ResourceData and schema.ResourceDiff
+// used to tell the changes of an instance.
+type resourceChange interface {
+	Get(string) interface{}
+	HasChange(string) bool
+}
+
+// instanceShutdownChanges returns the changed arguments for which
+// resourceIBMPIInstanceUpdate stops the instance.
+func instanceShutdownChanges(d resourceChange) []string {
+	if strings.ToLower(d.Get(Attr_Status).(string)) == State_Shutoff {
+		return nil
+	}
+	var changes []string
+	if d.HasChange(Arg_ProcType) {
+		changes = append(changes, Arg_ProcType)
+	}
+	if d.HasChange(Arg_Memory) || d.HasChange(Arg_Processors) {
+		if d.Get(Arg_Memory).(float64) > d.Get(Attr_MaxMemory).(float64) {
+			changes = append(changes, Arg_Memory)
+		}
+		if d.Get(Arg_Processors).(float64) > d.Get(Attr_MaxProcessors).(float64) {
+			changes = append(changes, Arg_Processors)
+		}
+	}
+	if d.HasChange(Arg_SAPProfileID) {
+		changes = append(changes, Arg_SAPProfileID)
+	}
+	if d.HasChange(Arg_VirtualSerialNumber + ".0." + Attr_Serial) {
+		changes = append(changes, Arg_VirtualSerialNumber)
+	}
+	return changes
+}
+
+func validateMaintenanceWindowSchedule(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseMaintenanceWindow(v.(string), "1h", "UTC"); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+	return
+}
+
+func validateMaintenanceWindowDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseMaintenanceWindow("* * * * *", v.(string), "UTC"); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+	return
+}
+
+func validateMaintenanceWindowTimeZone(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s: invalid time zone %q: %s", k, v, err))
+	}
+	return
+}
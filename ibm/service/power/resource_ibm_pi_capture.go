@@ -41,6 +41,7 @@ func ResourceIBMPICapture() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			resumeIBMPIJobCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -127,6 +128,16 @@ func ResourceIBMPICapture() *schema.Resource {
 				Description: "The image id of the capture instance.",
 				Type:        schema.TypeString,
 			},
+			Attr_JobID: {
+				Computed:    true,
+				Description: "The ID of the capture job.",
+				Type:        schema.TypeString,
+			},
+			Attr_JobStatus: {
+				Computed:    true,
+				Description: "The state of the capture job. The next apply resumes waiting for a job which was still running after the create timeout.",
+				Type:        schema.TypeString,
+			},
 		},
 	}
 }
@@ -189,10 +200,15 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, capturename, capturedestination))
-	jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
-	if err != nil {
-		return diag.FromErr(err)
+	d.Set(Attr_JobID, *captureResponse.ID)
+	if err := waitForIBMPIResourceJob(ctx, d, meta, cloudInstanceID, "ibm_pi_capture", "create"); err != nil {
+		if isIBMPIJobTimeout(err) {
+			// An error would taint the capture, it is kept with a warning
+			// instead and the update resuming the wait attaches the user tags.
+			d.Set(Arg_UserTags, nil)
+			return diag.Diagnostics{{Severity: diag.Warning, Summary: err.Error()}}
+		}
+		return ibmPIJobDiagnostics(err)
 	}
 
 	if _, ok := d.GetOk(Arg_UserTags); ok && capturedestination != CloudStorage {
@@ -228,6 +244,13 @@ func resourceIBMPICaptureRead(ctx context.Context, d *schema.ResourceData, meta
 	cloudInstanceID := parts[0]
 	captureID := parts[1]
 	capturedestination := parts[2]
+	if running, err := refreshIBMPIResourceJob(ctx, d, meta, cloudInstanceID); err != nil {
+		return diag.FromErr(err)
+	} else if running {
+		// The image of the capture does not exist until the job completes.
+		d.Set(Arg_CloudInstanceID, cloudInstanceID)
+		return nil
+	}
 	if capturedestination != CloudStorage {
 		imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 		imagedata, err := imageClient.Get(captureID)
@@ -293,11 +316,32 @@ func resourceIBMPICaptureUpdate(ctx context.Context, d *schema.ResourceData, met
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	cloudInstanceID := parts[0]
 	captureID := parts[1]
 	capturedestination := parts[2]
 
+	if d.HasChange(Attr_JobStatus) {
+		if err := waitForIBMPIResourceJob(ctx, d, meta, cloudInstanceID, "ibm_pi_capture", "update"); err != nil {
+			return ibmPIJobDiagnostics(err)
+		}
+	}
+
 	if capturedestination != CloudStorage && d.HasChange(Arg_UserTags) {
-		if crn, ok := d.GetOk(Attr_CRN); ok {
+		crn, ok := d.GetOk(Attr_CRN)
+		if !ok {
+			// The capture was created by a job which was still running after
+			// the create timeout.
+			sess, err := meta.(conns.ClientSession).IBMPISession()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			imagedata, err := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID).Get(captureID)
+			if err != nil {
+				return diag.Errorf("Error on get of ibm pi capture (%s) while applying pi_user_tags: %s", captureID, err)
+			}
+			crn, ok = string(imagedata.Crn), imagedata.Crn != ""
+		}
+		if ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
@@ -13,9 +13,12 @@ import (
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -254,3 +257,69 @@ func testAccCheckIBMPICaptureBothConfig(name string) string {
 	}
 	`, acc.Pi_cloud_instance_id, name, acc.Pi_instance_name, acc.Pi_capture_cloud_storage_region, acc.Pi_capture_cloud_storage_access_key, acc.Pi_capture_cloud_storage_secret_key, acc.Pi_capture_storage_image_path)
 }
+
+func TestUnitIBMPICapture_resume(t *testing.T) {
+	const jobPath = "/pcloud/v1/cloud-instances/ci/jobs/job-1"
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"pi_cloud_instance_id":   "ci",
+		"pi_instance_name":       "vm",
+		"pi_capture_name":        "capture",
+		"pi_capture_destination": "image-catalog",
+		"timeouts":               map[string]interface{}{"create": "100ms"},
+	})
+	// capture returns the ibm_pi_capture resource of a provider using the
+	// server m, and its meta.
+	capture := func(m *unittest.MockServer) (*schema.Resource, interface{}) {
+		t.Helper()
+		t.Setenv("IBMCLOUD_PI_API_ENDPOINT", m.URL)
+		unittest.UseMockCredentials(t)
+		p := provider.Provider()
+		if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+			"zone": "dal10",
+			"poll_interval": []interface{}{
+				map[string]interface{}{"resource_types": []interface{}{"ibm_pi_capture"}, "min": "40ms"},
+			},
+		})); diags.HasError() {
+			t.Fatalf("Unable to configure provider: %v", diags)
+		}
+		return p.ResourcesMap["ibm_pi_capture"], p.Meta()
+	}
+
+	// The capture is still running after the create timeout and the refresh,
+	// it is kept with a warning rather than tainted.
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "POST", Path: "/pcloud/v2/cloud-instances/ci/pvm-instances/vm/capture", Status: 202, Times: 1, Body: []byte(`{"id":"job-1","href":"/jobs/job-1"}`)},
+		unittest.Fixture{Method: "GET", Path: jobPath, Body: []byte(`{"id":"job-1","status":{"state":"running","progress":"40%"}}`)},
+	)
+	r, meta := capture(m)
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+	if err != nil {
+		t.Fatalf("Diff returned an error: %s", err)
+	}
+	state, diags := r.Apply(context.Background(), nil, diff, meta)
+	if diags.HasError() || len(diags) != 1 || state.Attributes["job_status"] != "running" {
+		t.Fatalf("Create did not keep the capture of the running job: %v %v", state, diags)
+	}
+	state, diags = r.RefreshWithoutUpgrade(context.Background(), state, meta)
+	if diags.HasError() || state.ID == "" {
+		t.Fatalf("Refresh did not keep the capture of the running job: %v %v", state, diags)
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// The next apply resumes waiting for the job, which completes.
+	m = unittest.NewMockServer(t,
+		unittest.Fixture{Method: "GET", Path: jobPath, Body: []byte(`{"id":"job-1","status":{"state":"completed","progress":"100%"}}`)},
+		unittest.Fixture{Method: "GET", Path: "/pcloud/v1/cloud-instances/ci/images/capture", Body: []byte(`{"imageID":"image-1","name":"capture"}`)},
+	)
+	r, meta = capture(m)
+	diff, err = r.Diff(context.Background(), state, config, meta)
+	if err != nil || diff == nil || !diff.Attributes["job_status"].NewComputed {
+		t.Fatalf("Diff did not plan to resume waiting for the job: %v %v", diff, err)
+	}
+	state, diags = r.Apply(context.Background(), state, diff, meta)
+	if diags.HasError() || state.Attributes["job_status"] != "completed" || state.Attributes["image_id"] != "image-1" {
+		t.Errorf("Update did not wait for the job: %v %v", state, diags)
+	}
+}
@@ -251,7 +251,7 @@ func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceD
 		jobID := *cloudConnectionJob.JobRef.ID
 
 		client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutCreate), meta.(conns.ClientSession).PollInterval("ibm_pi_cloud_connection"))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -358,7 +358,7 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 			}
 		}
 		if cloudConnectionJob != nil {
-			_, err = waitForIBMPIJobCompleted(ctx, jobClient, *cloudConnectionJob.ID, d.Timeout(schema.TimeoutCreate))
+			_, err = waitForIBMPIJobCompleted(ctx, jobClient, *cloudConnectionJob.ID, d.Timeout(schema.TimeoutCreate), meta.(conns.ClientSession).PollInterval("ibm_pi_cloud_connection"))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -379,7 +379,7 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 				return diag.FromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate), meta.(conns.ClientSession).PollInterval("ibm_pi_cloud_connection"))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -393,7 +393,7 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 				return diag.FromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate), meta.(conns.ClientSession).PollInterval("ibm_pi_cloud_connection"))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -510,7 +510,7 @@ func resourceIBMPICloudConnectionDelete(ctx context.Context, d *schema.ResourceD
 	if deleteJob != nil {
 		jobID := *deleteJob.ID
 		client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutDelete))
+		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutDelete), meta.(conns.ClientSession).PollInterval("ibm_pi_cloud_connection"))
 		if err != nil {
 			return diag.FromErr(err)
 		}
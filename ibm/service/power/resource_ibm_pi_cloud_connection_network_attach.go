@@ -76,7 +76,7 @@ func resourceIBMPICloudConnectionNetworkAttachCreate(ctx context.Context, d *sch
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, cloudConnectionID, networkID))
 	if jobReference != nil {
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutCreate), meta.(conns.ClientSession).PollInterval("ibm_pi_cloud_connection_network_attach"))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -126,7 +126,7 @@ func resourceIBMPICloudConnectionNetworkAttachDelete(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 	if jobReference != nil {
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutDelete))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutDelete), meta.(conns.ClientSession).PollInterval("ibm_pi_cloud_connection_network_attach"))
 		if err != nil {
 			return diag.FromErr(err)
 		}
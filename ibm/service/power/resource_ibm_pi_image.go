@@ -341,7 +341,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate), meta.(conns.ClientSession).PollInterval("ibm_pi_image"))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -479,30 +479,3 @@ func isIBMPIImageRefreshFunc(ctx context.Context, client *instance.IBMPIImageCli
 		return image, State_Queued, nil
 	}
 }
-
-func waitForIBMPIJobCompleted(ctx context.Context, client *instance.IBMPIJobClient, jobID string, timeout time.Duration) (interface{}, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{State_Queued, State_ReadyForProcessing, State_inProgress, State_Running, State_Waiting},
-		Target:  []string{State_Completed, State_Failed},
-		Refresh: func() (interface{}, string, error) {
-			job, err := client.Get(jobID)
-			if err != nil {
-				log.Printf("[DEBUG] get job failed %v", err)
-				return nil, "", fmt.Errorf(errors.GetJobOperationFailed, jobID, err)
-			}
-			if job == nil || job.Status == nil {
-				log.Printf("[DEBUG] get job failed with empty response")
-				return nil, "", fmt.Errorf("failed to get job status for job id %s", jobID)
-			}
-			if *job.Status.State == State_Failed {
-				log.Printf("[DEBUG] job status failed with message: %v", job.Status.Message)
-				return nil, State_Failed, fmt.Errorf("job status failed for job id %s with message: %v", jobID, job.Status.Message)
-			}
-			return job, *job.Status.State, nil
-		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-	return stateConf.WaitForStateContext(ctx)
-}
@@ -76,6 +76,18 @@ func ResourceIBMPIImageExport() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+
+			// Attributes
+			Attr_JobID: {
+				Computed:    true,
+				Description: "The ID of the export job.",
+				Type:        schema.TypeString,
+			},
+			Attr_JobStatus: {
+				Computed:    true,
+				Description: "The state of the export job.",
+				Type:        schema.TypeString,
+			},
 		},
 	}
 }
@@ -107,16 +119,29 @@ func resourceIBMPIImageExportCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", imageid, bucketName, d.Get(Arg_ImageBucketRegion).(string)))
+	d.Set(Attr_JobID, *imageResponse.ID)
 
-	jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
-	if err != nil {
-		return diag.FromErr(err)
+	if err := waitForIBMPIResourceJob(ctx, d, meta, cloudInstanceID, "ibm_pi_image_export", "create"); err != nil {
+		if isIBMPIJobTimeout(err) {
+			// An error would taint the export, it is kept with a warning
+			// instead and its job is refreshed by the next plans.
+			return diag.Diagnostics{{Severity: diag.Warning, Summary: err.Error()}}
+		}
+		return ibmPIJobDiagnostics(err)
 	}
 	return nil
 }
 
 func resourceIBMPIImageExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if _, err := refreshIBMPIResourceJob(ctx, d, meta, d.Get(Arg_CloudInstanceID).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get(Attr_JobStatus).(string) == State_Failed {
+		// The job failed after the timeout of the last apply, the image is
+		// exported again.
+		log.Printf("[WARN] Export job %s of image %s failed, removing the export from the state", d.Get(Attr_JobID), d.Get(Arg_ImageID))
+		d.SetId("")
+	}
 	return nil
 }
 
@@ -4,11 +4,18 @@
 package power_test
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccIBMPIImageExport(t *testing.T) {
@@ -42,3 +49,91 @@ func testAccCheckIBMPIImageExportConfig() string {
 		}
 	`, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, acc.Pi_image_bucket_access_key, acc.Pi_image_bucket_secret_key, acc.Pi_image_bucket_region, acc.Pi_image)
 }
+
+func testUnitPIJob(id, state, message string) []byte {
+	return []byte(fmt.Sprintf(`{"id":%q,"operation":{"action":"imageExport","id":"image-1","target":"bucket"},"status":{"state":%q,"progress":"%s","message":%q}}`, id, state, state, message))
+}
+
+func TestUnitIBMPIImageExport_job(t *testing.T) {
+	const jobsPath = "/pcloud/v1/cloud-instances/ci/jobs"
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"pi_cloud_instance_id":   "ci",
+		"pi_image_id":            "image-1",
+		"pi_image_bucket_name":   "bucket",
+		"pi_image_bucket_region": "us-east",
+		"pi_image_access_key":    "access",
+		"pi_image_secret_key":    "secret",
+		"timeouts":               map[string]interface{}{"create": "200ms"},
+	})
+	// export returns the ibm_pi_image_export resource of a provider using the
+	// server m, and its meta.
+	export := func(m *unittest.MockServer) (*schema.Resource, interface{}) {
+		t.Helper()
+		// The Power endpoint is not part of the endpoints file.
+		t.Setenv("IBMCLOUD_PI_API_ENDPOINT", m.URL)
+		unittest.UseMockCredentials(t)
+		p := provider.Provider()
+		if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+			"zone": "dal10",
+			"poll_interval": []interface{}{
+				map[string]interface{}{"resource_types": []interface{}{"ibm_pi_*"}, "min": "10ms"},
+			},
+		})); diags.HasError() {
+			t.Fatalf("Unable to configure provider: %v", diags)
+		}
+		return p.ResourcesMap["ibm_pi_image_export"], p.Meta()
+	}
+	apply := func(m *unittest.MockServer, state *terraform.InstanceState) (*terraform.InstanceState, diag.Diagnostics) {
+		t.Helper()
+		r, meta := export(m)
+		diff, err := r.Diff(context.Background(), state, config, meta)
+		if err != nil {
+			t.Fatalf("Diff returned an error: %s", err)
+		}
+		return r.Apply(context.Background(), state, diff, meta)
+	}
+
+	// The export still running after the create timeout is kept with a
+	// warning, so that it is not tainted.
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "POST", Path: "/pcloud/v2/cloud-instances/ci/images/image-1/export", Status: 202, Times: 1, Body: []byte(`{"id":"job-1","href":"/jobs/job-1"}`)},
+		unittest.Fixture{Method: "GET", Path: jobsPath + "/job-1", Body: testUnitPIJob("job-1", "running", "")},
+	)
+	state, diags := apply(m, nil)
+	if diags.HasError() || len(diags) != 1 || !strings.Contains(diags[0].Summary, "job job-1 is still running") || state.Attributes["job_status"] != "running" {
+		t.Fatalf("Create did not time out with a warning: %v %v", state, diags)
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// The job in the state is refreshed, without looking for the jobs of the
+	// image, and a failed export reports the message of its job.
+	m = unittest.NewMockServer(t,
+		unittest.Fixture{Method: "GET", Path: jobsPath + "/job-1", Body: testUnitPIJob("job-1", "completed", "")},
+		unittest.Fixture{Method: "POST", Path: "/pcloud/v2/cloud-instances/ci/images/image-1/export", Status: 202, Times: 1, Body: []byte(`{"id":"job-2","href":"/jobs/job-2"}`)},
+		unittest.Fixture{Method: "GET", Path: jobsPath + "/job-2", Body: testUnitPIJob("job-2", "failed", "bucket quota exceeded")},
+	)
+	r, meta := export(m)
+	state, diags = r.RefreshWithoutUpgrade(context.Background(), state, meta)
+	if diags.HasError() || state.Attributes["job_id"] != "job-1" || state.Attributes["job_status"] != "completed" {
+		t.Errorf("Refresh did not refresh the job: %v %v", state, diags)
+	}
+	if diff, err := r.Diff(context.Background(), state, config, meta); err != nil || diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("Diff planned a change of the completed export: %v %v", diff, err)
+	}
+	if _, diags = apply(m, nil); !diags.HasError() || !strings.Contains(diags[0].Summary, "bucket quota exceeded") || !strings.Contains(diags[0].Summary, "ibm_pi_image_export") {
+		t.Errorf("Create did not report the failure of the job: %v", diags)
+	}
+	// An export whose job failed after the create timeout is exported again.
+	state.Attributes["job_id"], state.Attributes["job_status"] = "job-2", "running"
+	if state, diags = r.RefreshWithoutUpgrade(context.Background(), state, meta); diags.HasError() || state != nil && state.ID != "" {
+		t.Errorf("Refresh kept the export of the failed job: %v %v", state, diags)
+	}
+	if calls := m.Calls("GET", jobsPath); calls != 0 {
+		t.Errorf("The jobs were listed %d times", calls)
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
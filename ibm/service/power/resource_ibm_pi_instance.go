@@ -41,6 +41,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			resourceIBMPIInstanceShutdownCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -164,6 +165,35 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeInt,
 			},
+			Arg_MaintenanceWindow: {
+				Description: "The maintenance window in which the changes stopping the instance are allowed; they fail at plan time outside of it",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Duration: {
+							Description:  "Duration of the window, for example 4h",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validateMaintenanceWindowDuration,
+						},
+						Attr_Schedule: {
+							Description:  "Cron schedule of the start of the window: minute, hour, day of month, month and day of week, for example 0 2 * * SAT",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validateMaintenanceWindowSchedule,
+						},
+						Attr_TimeZone: {
+							Default:      "UTC",
+							Description:  "IANA time zone of the schedule, for example Europe/Berlin",
+							Optional:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validateMaintenanceWindowTimeZone,
+						},
+					},
+				},
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
 			Arg_Memory: {
 				Computed:      true,
 				ConflictsWith: []string{Arg_SAPProfileID},
@@ -484,6 +514,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "Shared Processor Pool ID the instance is deployed on",
 				Type:        schema.TypeString,
 			},
+			Attr_ShutdownRequired: {
+				Computed:    true,
+				Description: "Whether the planned update stops the instance",
+				Type:        schema.TypeBool,
+			},
 			Attr_Status: {
 				Computed:    true,
 				Description: "PI instance status",
@@ -622,6 +657,8 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	if powervmdata.Status != nil {
 		d.Set(Attr_Status, powervmdata.Status)
 	}
+	// An applied plan leaves no pending change to stop the instance for.
+	d.Set(Attr_ShutdownRequired, false)
 	d.Set(Arg_CloudInstanceID, cloudInstanceID)
 	d.Set(Arg_ImageID, powervmdata.ImageID)
 	d.Set(Arg_InstanceName, powervmdata.ServerName)
@@ -740,6 +777,15 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 	cores_enabled := checkCloudInstanceCapability(cloudInstance, CUSTOM_VIRTUAL_CORES)
 
+	// The plan may be applied after the end of the maintenance window.
+	window, err := maintenanceWindowFromList(d.Get(Arg_MaintenanceWindow).([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkMaintenanceWindow(window, instanceShutdownChanges(d), time.Now()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChanges(Arg_InstanceName, Arg_VirtualOpticalDevice) {
 		if d.HasChange(Arg_InstanceName) && d.HasChange(Arg_VirtualOpticalDevice) {
 			oldVOD, _ := d.GetChange(Arg_VirtualOpticalDevice)
@@ -1087,6 +1133,30 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	return resourceIBMPIInstanceRead(ctx, d, meta)
 }
 
+// resourceIBMPIInstanceShutdownCustomizeDiff tells in shutdown_required
+// whether the planned update stops the instance, and fails the plan when it
+// does outside of the maintenance window.
+func resourceIBMPIInstanceShutdownCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || len(diff.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+	changes := instanceShutdownChanges(diff)
+	for _, k := range []string{Arg_Memory, Arg_Processors} {
+		// An unknown size may exceed the maximum of the instance.
+		if diff.HasChange(k) && !diff.NewValueKnown(k) {
+			changes = append(changes, k)
+		}
+	}
+	if err := diff.SetNew(Attr_ShutdownRequired, len(changes) > 0); err != nil {
+		return err
+	}
+	window, err := maintenanceWindowFromList(diff.Get(Arg_MaintenanceWindow).([]interface{}))
+	if err != nil {
+		return err
+	}
+	return checkMaintenanceWindow(window, changes, time.Now())
+}
+
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
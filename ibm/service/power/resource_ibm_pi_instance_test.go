@@ -1068,3 +1068,86 @@ func testAccCheckIBMPIInstanceStatus(n, status string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestUnitIBMPIInstance_maintenanceWindow(t *testing.T) {
+	r := power.ResourceIBMPIInstance()
+	state := &terraform.InstanceState{
+		ID: "ci/instance-1",
+		Attributes: map[string]string{
+			"id":                      "ci/instance-1",
+			"pi_cloud_instance_id":    "ci",
+			"pi_image_id":             "image-1",
+			"pi_instance_name":        "instance",
+			"pi_network.#":            "1",
+			"pi_network.0.network_id": "network-1",
+			"pi_memory":               "4",
+			"pi_processors":           "0.5",
+			"pi_proc_type":            "shared",
+			"max_memory":              "16",
+			"max_processors":          "2",
+			"status":                  "ACTIVE",
+			"shutdown_required":       "false",
+			"pi_replicants":           "1",
+			"pi_replication_policy":   "none",
+			"pi_replication_scheme":   "suffix",
+		},
+	}
+	later := time.Now().UTC().Add(3 * time.Hour)
+	config := func(memory int, procType string, window map[string]interface{}) *terraform.ResourceConfig {
+		raw := map[string]interface{}{
+			"pi_cloud_instance_id": "ci",
+			"pi_image_id":          "image-1",
+			"pi_instance_name":     "instance",
+			"pi_network":           []interface{}{map[string]interface{}{"network_id": "network-1"}},
+			"pi_memory":            memory,
+			"pi_processors":        0.5,
+			"pi_proc_type":         procType,
+		}
+		if window != nil {
+			raw["pi_maintenance_window"] = []interface{}{window}
+		}
+		return terraform.NewResourceConfigRaw(raw)
+	}
+	always := map[string]interface{}{"schedule": "* * * * *", "duration": "1m"}
+	outside := map[string]interface{}{"schedule": fmt.Sprintf("%d %d * * *", later.Minute(), later.Hour()), "duration": "1h"}
+
+	for _, c := range []struct {
+		name     string
+		config   *terraform.ResourceConfig
+		shutdown string
+		err      string
+	}{
+		{"resize within the maximum", config(8, "shared", nil), "false", ""},
+		{"resize beyond the maximum", config(32, "shared", nil), "true", ""},
+		{"processor type", config(4, "dedicated", nil), "true", ""},
+		{"inside the window", config(4, "dedicated", always), "true", ""},
+		{"outside the window", config(32, "shared", outside), "", "the change of pi_memory stops the instance, which is only allowed in its pi_maintenance_window"},
+		{"resize outside the window", config(8, "shared", outside), "false", ""},
+	} {
+		diff, err := r.Diff(context.Background(), state, c.config, nil)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) || !strings.Contains(err.Error(), "the next window starts at "+later.Format("2006-01-02T15:04")) {
+				t.Errorf("%s: Diff returned %v, expected %s", c.name, err, c.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Diff returned an error: %s", c.name, err)
+			continue
+		}
+		got := state.Attributes["shutdown_required"]
+		if attr := diff.Attributes["shutdown_required"]; attr != nil {
+			got = attr.New
+		}
+		if got != c.shutdown {
+			t.Errorf("%s: Diff planned shutdown_required %s, expected %s", c.name, got, c.shutdown)
+		}
+	}
+
+	if diags := r.Validate(config(4, "shared", map[string]interface{}{"schedule": "0 25 * * MON", "duration": "1h"})); !diags.HasError() {
+		t.Error("Validate accepted an hour out of range")
+	}
+	if diags := r.Validate(config(4, "shared", map[string]interface{}{"schedule": "0 2 * * SAT,SUN", "duration": "4h", "time_zone": "Europe/Berlin"})); diags.HasError() {
+		t.Errorf("Validate rejected a valid window: %v", diags)
+	}
+}
@@ -164,7 +164,7 @@ func resourceIBMPIVPNConnectionCreate(ctx context.Context, d *schema.ResourceDat
 		jobID := *vpnConnection.JobRef.ID
 		jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
 
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate), meta.(conns.ClientSession).PollInterval("ibm_pi_vpn_connection"))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -226,7 +226,7 @@ func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceDat
 				return diag.FromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate), meta.(conns.ClientSession).PollInterval("ibm_pi_vpn_connection"))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -238,7 +238,7 @@ func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceDat
 				return diag.FromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate), meta.(conns.ClientSession).PollInterval("ibm_pi_vpn_connection"))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -353,7 +353,7 @@ func resourceIBMPIVPNConnectionDelete(ctx context.Context, d *schema.ResourceDat
 	}
 	if jobRef != nil {
 		jobID := *jobRef.ID
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate), meta.(conns.ClientSession).PollInterval("ibm_pi_vpn_connection"))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func DataSourceIbmSmSecretVersion() *schema.Resource {
+	dataSourceSchema := map[string]*schema.Schema{
+		"secret_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The ID of the secret.",
+		},
+		"version_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "current",
+			Description: "The ID of the secret version, or the `current` or `previous` alias.",
+		},
+		"secret_version_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the secret version, with the alias resolved.",
+		},
+		"version_custom_metadata": &schema.Schema{
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "The secret version metadata that a user can customize.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	for k, v := range secretVersionMetadataSchema() {
+		dataSourceSchema[k] = v
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretVersionRead,
+
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceIbmSmSecretVersionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", SecretVersionResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	versionMetadata, response, err := getSecretVersionMetadata(context, secretsManagerClient, secretId, d.Get("version_id").(string))
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", SecretVersionResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	versionId := flex.StringValue(versionMetadata.ID)
+	d.SetId(secretVersionID(region, instanceId, secretId, versionId))
+
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting region"), fmt.Sprintf("(Data) %s", SecretVersionResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_version_id", versionId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting secret_version_id"), fmt.Sprintf("(Data) %s", SecretVersionResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = setSecretVersionMetadata(d, versionMetadata); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), fmt.Sprintf("(Data) %s", SecretVersionResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func DataSourceIbmSmSecretVersionLocks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretVersionLocksRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the secret.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "current",
+				Description: "The ID of the secret version, or the `current` or `previous` alias.",
+			},
+			"secret_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret version, with the alias resolved.",
+			},
+			"locks": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The locks of the secret version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the lock.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An extended description of the lock.",
+						},
+						"attributes": &schema.Schema{
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Optional information associated with the lock.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the lock was created. The date format follows RFC 3339.",
+						},
+						"created_by": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier that is associated with the entity that created the lock.",
+						},
+					},
+				},
+			},
+			"total_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of resources in a collection.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretVersionLocksRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	versionMetadata, response, err := getSecretVersionMetadata(context, secretsManagerClient, secretId, d.Get("version_id").(string))
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	versionId := flex.StringValue(versionMetadata.ID)
+
+	locks, response, err := listSecretVersionLocks(context, secretsManagerClient, secretId, versionId)
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretVersionLocksWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecretVersionLocksWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(secretVersionID(region, instanceId, secretId, versionId))
+
+	lockMaps := []map[string]interface{}{}
+	for i := range locks {
+		lockMaps = append(lockMaps, secretLockToMap(&locks[i]))
+	}
+	if err = d.Set("locks", lockMaps); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting locks"), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("total_count", len(locks)); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting total_count"), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_version_id", versionId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting secret_version_id"), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting region"), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func DataSourceIbmSmSecretVersions() *schema.Resource {
+	versionSchema := map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the secret version.",
+		},
+		"version_custom_metadata": &schema.Schema{
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "The secret version metadata that a user can customize.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	for k, v := range secretVersionMetadataSchema() {
+		versionSchema[k] = v
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the secret.",
+			},
+			"versions": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the secret.",
+				Elem:        &schema.Resource{Schema: versionSchema},
+			},
+			"total_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of resources in a collection.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretVersionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	listSecretVersionsOptions := &secretsmanagerv2.ListSecretVersionsOptions{}
+	listSecretVersionsOptions.SetSecretID(secretId)
+
+	secretVersionMetadataCollection, response, err := secretsManagerClient.ListSecretVersionsWithContext(context, listSecretVersionsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretVersionsWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecretVersionsWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	versions := []map[string]interface{}{}
+	for _, versionIntf := range secretVersionMetadataCollection.Versions {
+		versionMetadata, err := secretVersionMetadata(versionIntf)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+			return tfErr.GetDiag()
+		}
+		versions = append(versions, dataSourceIbmSmSecretVersionsSecretVersionMetadataToMap(versionMetadata))
+	}
+	if err = d.Set("versions", versions); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting versions"), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("total_count", flex.IntValue(secretVersionMetadataCollection.TotalCount)); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting total_count"), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting region"), fmt.Sprintf("(Data) %s", SecretVersionsResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
+
+func dataSourceIbmSmSecretVersionsSecretVersionMetadataToMap(model *secretsmanagerv2.SecretVersionMetadata) map[string]interface{} {
+	modelMap := map[string]interface{}{
+		"id":                flex.StringValue(model.ID),
+		"secret_type":       flex.StringValue(model.SecretType),
+		"secret_name":       flex.StringValue(model.SecretName),
+		"secret_group_id":   flex.StringValue(model.SecretGroupID),
+		"alias":             flex.StringValue(model.Alias),
+		"created_by":        flex.StringValue(model.CreatedBy),
+		"created_at":        DateTimeToRFC3339(model.CreatedAt),
+		"expiration_date":   DateTimeToRFC3339(model.ExpirationDate),
+		"auto_rotated":      model.AutoRotated != nil && *model.AutoRotated,
+		"downloaded":        model.Downloaded != nil && *model.Downloaded,
+		"payload_available": model.PayloadAvailable != nil && *model.PayloadAvailable,
+	}
+	if model.VersionCustomMetadata != nil {
+		modelMap["version_custom_metadata"] = model.VersionCustomMetadata
+	}
+	return modelMap
+}
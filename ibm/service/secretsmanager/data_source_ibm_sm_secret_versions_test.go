@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretVersionsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "versions.#", "2"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_versions.sm_secret_versions", "versions.0.id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "versions.0.secret_type", "arbitrary"),
+					resource.TestCheckResourceAttrPair("data.ibm_sm_secret_version.sm_secret_version", "secret_version_id", "ibm_sm_secret_version.sm_secret_version", "version_id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version.sm_secret_version", "alias", "current"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_version.sm_secret_version", "created_at"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version.sm_secret_version_previous", "alias", "previous"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretVersionsDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id = "%[1]s"
+			region      = "%[2]s"
+			name        = "terraform-test-secret-versions"
+			payload     = "secret-credentials"
+			lifecycle {
+				ignore_changes = [payload]
+			}
+		}
+
+		resource "ibm_sm_secret_version" "sm_secret_version" {
+			instance_id = "%[1]s"
+			region      = "%[2]s"
+			secret_id   = ibm_sm_arbitrary_secret.sm_arbitrary_secret.secret_id
+			payload     = "secret-credentials-v2"
+		}
+
+		data "ibm_sm_secret_versions" "sm_secret_versions" {
+			instance_id = "%[1]s"
+			region      = "%[2]s"
+			secret_id   = ibm_sm_secret_version.sm_secret_version.secret_id
+		}
+
+		data "ibm_sm_secret_version" "sm_secret_version" {
+			instance_id = "%[1]s"
+			region      = "%[2]s"
+			secret_id   = ibm_sm_secret_version.sm_secret_version.secret_id
+		}
+
+		data "ibm_sm_secret_version" "sm_secret_version_previous" {
+			instance_id = "%[1]s"
+			region      = "%[2]s"
+			secret_id   = ibm_sm_secret_version.sm_secret_version.secret_id
+			version_id  = "previous"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
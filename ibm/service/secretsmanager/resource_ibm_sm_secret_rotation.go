@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// rotatableSecretTypes are the secret types whose new versions are generated
+// by Secrets Manager.
+var rotatableSecretTypes = map[string]bool{
+	UsernamePasswordSecretType:   true,
+	IAMCredentialsSecretType:     true,
+	ServiceCredentialsSecretType: true,
+	PublicCertSecretType:         true,
+	PrivateCertSecretType:        true,
+}
+
+func ResourceIbmSmSecretRotation() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"secret_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The ID of the secret to rotate.",
+		},
+		"rotate_keys": &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Whether a new private key is requested when a `public_cert` secret is rotated.",
+		},
+		"triggers": &schema.Schema{
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Arbitrary values whose change rotates the secret again.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"version_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the secret version created by the rotation.",
+		},
+		"version_custom_metadata": &schema.Schema{
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "The secret version metadata that a user can customize.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	for k, v := range secretVersionMetadataSchema() {
+		resourceSchema[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretRotationCreate,
+		ReadContext:   resourceIbmSmSecretRotationRead,
+		UpdateContext: resourceIbmSmSecretRotationUpdate,
+		DeleteContext: resourceIbmSmSecretRotationDelete,
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceIbmSmSecretRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	// The version prototype depends on the type of the secret
+	secretId := d.Get("secret_id").(string)
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretMetadataWithContext failed %s\n%s", err, response), SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	secretMetadata := &secretsmanagerv2.SecretMetadata{}
+	if err = remarshalSecretModel(secretMetadataIntf, secretMetadata); err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	secretType := flex.StringValue(secretMetadata.SecretType)
+	if !rotatableSecretTypes[secretType] {
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("Secrets Manager does not generate the versions of %s secrets, create the version with %s instead", secretType, SecretVersionResourceName), SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	versionModel := &secretsmanagerv2.SecretVersionPrototype{}
+	if secretType == PublicCertSecretType {
+		versionModel.Rotation = &secretsmanagerv2.PublicCertificateRotationObject{
+			RotateKeys: core.BoolPtr(d.Get("rotate_keys").(bool)),
+		}
+	}
+
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretId)
+	createSecretVersionOptions.SetSecretVersionPrototype(versionModel)
+
+	secretVersionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecretVersionWithContext failed %s\n%s", err, response), SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	secretVersion, err := secretVersionMetadata(secretVersionIntf)
+	if err != nil || secretVersion.ID == nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecretVersionWithContext returned no version ID\n%s", response), SecretRotationResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	d.SetId(secretVersionID(region, instanceId, secretId, *secretVersion.ID))
+
+	return resourceIbmSmSecretRotationRead(context, d, meta)
+}
+
+func resourceIbmSmSecretRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := parseSecretVersionID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	versionMetadata, response, err := getSecretVersionMetadata(context, secretsManagerClient, secretId, versionId)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response), SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting region"), SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("version_id", versionId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting version_id"), SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = setSecretVersionMetadata(d, versionMetadata); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretRotationResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
+
+// resourceIbmSmSecretRotationUpdate only refreshes the rotation, every
+// argument but endpoint_type forces a new rotation.
+func resourceIbmSmSecretRotationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIbmSmSecretRotationRead(context, d, meta)
+}
+
+// resourceIbmSmSecretRotationDelete removes the rotation from the state, the
+// version it created is kept by Secrets Manager.
+func resourceIbmSmSecretRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
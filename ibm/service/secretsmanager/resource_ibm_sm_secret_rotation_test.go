@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretRotationBasic(t *testing.T) {
+	resourceName := "ibm_sm_secret_rotation.sm_secret_rotation"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: secretRotationConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "username_password"),
+					resource.TestCheckResourceAttr(resourceName, "alias", "current"),
+				),
+			},
+			resource.TestStep{
+				Config: secretRotationConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "alias", "current"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "versions_total", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmSmSecretRotationUnsupportedType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(`
+					resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+						instance_id = "%s"
+						region      = "%s"
+						name        = "terraform-test-secret-rotation"
+						payload     = "secret-credentials"
+					}
+
+					resource "ibm_sm_secret_rotation" "sm_secret_rotation" {
+						instance_id = "%s"
+						region      = "%s"
+						secret_id   = ibm_sm_arbitrary_secret.sm_arbitrary_secret.secret_id
+					}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+					acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion),
+				ExpectError: regexp.MustCompile("does not generate the versions of arbitrary secrets"),
+			},
+		},
+	})
+}
+
+func secretRotationConfig(trigger string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			instance_id = "%s"
+			region      = "%s"
+			name        = "terraform-test-secret-rotation"
+			username    = "username"
+			password    = "password-v1"
+			lifecycle {
+				ignore_changes = [password]
+			}
+		}
+
+		resource "ibm_sm_secret_rotation" "sm_secret_rotation" {
+			instance_id = "%s"
+			region      = "%s"
+			secret_id   = ibm_sm_username_password_secret.sm_username_password_secret.secret_id
+			triggers = {
+				rotation = "%s"
+			}
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, trigger)
+}
@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmSecretVersion() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"secret_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The ID of the secret.",
+		},
+		"payload": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "The secret data of an `arbitrary` secret.",
+		},
+		"password": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "The password of a `username_password` secret. If omitted, Secrets Manager generates a new random password.",
+		},
+		"data": &schema.Schema{
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "The payload data of a `kv` secret.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"certificate": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The PEM-encoded certificate of an `imported_cert` secret.",
+		},
+		"intermediate": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The PEM-encoded intermediate certificate of an `imported_cert` secret.",
+		},
+		"private_key": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "The PEM-encoded private key of an `imported_cert` secret.",
+		},
+		"csr": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The certificate signing request of a `private_cert` secret.",
+		},
+		"restore_from_version": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The version of an `iam_credentials` secret to restore, a UUID or the `previous` alias.",
+		},
+		"custom_metadata": &schema.Schema{
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The secret metadata that a user can customize, set on the secret with the new version.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"version_custom_metadata": &schema.Schema{
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Computed:    true,
+			Description: "The secret version metadata that a user can customize.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"triggers": &schema.Schema{
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Arbitrary values whose change creates a new version of the secret.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"version_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the secret version.",
+		},
+	}
+	for k, v := range secretVersionMetadataSchema() {
+		resourceSchema[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretVersionCreate,
+		ReadContext:   resourceIbmSmSecretVersionRead,
+		UpdateContext: resourceIbmSmSecretVersionUpdate,
+		DeleteContext: resourceIbmSmSecretVersionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceIbmSmSecretVersionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretId)
+	createSecretVersionOptions.SetSecretVersionPrototype(resourceIbmSmSecretVersionMapToSecretVersionPrototype(d))
+
+	secretVersionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecretVersionWithContext failed %s\n%s", err, response), SecretVersionResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	secretVersion, err := secretVersionMetadata(secretVersionIntf)
+	if err != nil || secretVersion.ID == nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecretVersionWithContext returned no version ID\n%s", response), SecretVersionResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	d.SetId(secretVersionID(region, instanceId, secretId, *secretVersion.ID))
+
+	return resourceIbmSmSecretVersionRead(context, d, meta)
+}
+
+func resourceIbmSmSecretVersionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := parseSecretVersionID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	versionMetadata, response, err := getSecretVersionMetadata(context, secretsManagerClient, secretId, versionId)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response), SecretVersionResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting instance_id"), SecretVersionResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting region"), SecretVersionResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting secret_id"), SecretVersionResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("version_id", versionId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting version_id"), SecretVersionResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = setSecretVersionMetadata(d, versionMetadata); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIbmSmSecretVersionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionResourceName, "update")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := parseSecretVersionID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionResourceName, "update")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	if d.HasChange("version_custom_metadata") {
+		secretVersionMetadataPatchModel := new(secretsmanagerv2.SecretVersionMetadataPatch)
+		secretVersionMetadataPatchModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+		secretVersionMetadataPatchModelAsPatch, _ := secretVersionMetadataAsPatchFunction(secretVersionMetadataPatchModel)
+
+		updateSecretVersionOptions := &secretsmanagerv2.UpdateSecretVersionMetadataOptions{}
+		updateSecretVersionOptions.SetSecretID(secretId)
+		updateSecretVersionOptions.SetID(versionId)
+		updateSecretVersionOptions.SetSecretVersionMetadataPatch(secretVersionMetadataPatchModelAsPatch)
+		_, response, err := secretsManagerClient.UpdateSecretVersionMetadataWithContext(context, updateSecretVersionOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response), SecretVersionResourceName, "update")
+			return tfErr.GetDiag()
+		}
+	}
+
+	return resourceIbmSmSecretVersionRead(context, d, meta)
+}
+
+// resourceIbmSmSecretVersionDelete removes the version from the state. The
+// versions of a secret are kept by Secrets Manager until the secret is
+// deleted.
+func resourceIbmSmSecretVersionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] The secret version %s is kept by Secrets Manager until its secret is deleted", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceIbmSmSecretVersionMapToSecretVersionPrototype(d *schema.ResourceData) *secretsmanagerv2.SecretVersionPrototype {
+	model := &secretsmanagerv2.SecretVersionPrototype{}
+	if _, ok := d.GetOk("payload"); ok {
+		model.Payload = core.StringPtr(d.Get("payload").(string))
+	}
+	if _, ok := d.GetOk("password"); ok {
+		model.Password = core.StringPtr(d.Get("password").(string))
+	}
+	if _, ok := d.GetOk("data"); ok {
+		model.Data = d.Get("data").(map[string]interface{})
+	}
+	if _, ok := d.GetOk("certificate"); ok {
+		model.Certificate = core.StringPtr(d.Get("certificate").(string))
+	}
+	if _, ok := d.GetOk("intermediate"); ok {
+		model.Intermediate = core.StringPtr(d.Get("intermediate").(string))
+	}
+	if _, ok := d.GetOk("private_key"); ok {
+		model.PrivateKey = core.StringPtr(d.Get("private_key").(string))
+	}
+	if _, ok := d.GetOk("csr"); ok {
+		model.Csr = core.StringPtr(d.Get("csr").(string))
+	}
+	if _, ok := d.GetOk("restore_from_version"); ok {
+		model.RestoreFromVersion = core.StringPtr(d.Get("restore_from_version").(string))
+	}
+	if _, ok := d.GetOk("custom_metadata"); ok {
+		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	}
+	if _, ok := d.GetOk("version_custom_metadata"); ok {
+		model.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
+	}
+	return model
+}
@@ -0,0 +1,409 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// secretVersionLocksPageSize is the number of locks listed by request.
+const secretVersionLocksPageSize = 200
+
+func ResourceIbmSmSecretVersionLocks() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretVersionLocksCreate,
+		ReadContext:   resourceIbmSmSecretVersionLocksRead,
+		UpdateContext: resourceIbmSmSecretVersionLocksUpdate,
+		DeleteContext: resourceIbmSmSecretVersionLocksDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret version to lock, or the `current` or `previous` alias. An alias is resolved to the ID of the version when the locks are created.",
+			},
+			"mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{secretsmanagerv2.CreateSecretVersionLocksBulkOptions_Mode_RemovePrevious, secretsmanagerv2.CreateSecretVersionLocksBulkOptions_Mode_RemovePreviousAndDelete}, false),
+				Description:  "How the locks with the same names on the other versions of the secret are handled when the locks are created: `remove_previous` removes them, `remove_previous_and_delete` also deletes the data of the versions left without locks.",
+			},
+			"locks": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The locks of the secret version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "A human-readable name to assign to the lock. The name must be unique per secret version.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "An extended description of the lock.",
+						},
+						"attributes": &schema.Schema{
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Optional information to associate with the lock, such as the CRN of the service which uses the secret version.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the lock was created. The date format follows RFC 3339.",
+						},
+						"created_by": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier that is associated with the entity that created the lock.",
+						},
+					},
+				},
+			},
+			"secret_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the locked secret version.",
+			},
+			"secret_version_alias": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The alias of the locked secret version, `current` or `previous`, if any.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmSecretVersionLocksCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionLocksResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	// Resolve an alias, so that the locks stay on the version after a rotation
+	secretId := d.Get("secret_id").(string)
+	versionMetadata, response, err := getSecretVersionMetadata(context, secretsManagerClient, secretId, d.Get("version_id").(string))
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response), SecretVersionLocksResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	versionId := flex.StringValue(versionMetadata.ID)
+
+	if err = createSecretVersionLocks(context, secretsManagerClient, d, secretId, versionId, d.Get("locks").([]interface{})); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionLocksResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	d.SetId(secretVersionID(region, instanceId, secretId, versionId))
+
+	return resourceIbmSmSecretVersionLocksRead(context, d, meta)
+}
+
+func resourceIbmSmSecretVersionLocksRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := parseSecretVersionID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	locks, response, err := listSecretVersionLocks(context, secretsManagerClient, secretId, versionId)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] ListSecretVersionLocksWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecretVersionLocksWithContext failed %s\n%s", err, response), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	// Keep the order of the managed locks, the other locks of the version are
+	// only read on import
+	locksByName := map[string]secretsmanagerv2.SecretLock{}
+	for _, lock := range locks {
+		locksByName[flex.StringValue(lock.Name)] = lock
+	}
+	var names []string
+	for _, lockIntf := range d.Get("locks").([]interface{}) {
+		if lockMap, ok := lockIntf.(map[string]interface{}); ok {
+			names = append(names, lockMap["name"].(string))
+		}
+	}
+	if len(names) == 0 {
+		for _, lock := range locks {
+			names = append(names, flex.StringValue(lock.Name))
+		}
+	}
+	lockMaps := []map[string]interface{}{}
+	var alias string
+	for _, name := range names {
+		lock, ok := locksByName[name]
+		if !ok {
+			continue
+		}
+		lockMaps = append(lockMaps, secretLockToMap(&lock))
+		alias = flex.StringValue(lock.SecretVersionAlias)
+	}
+	if len(lockMaps) == 0 {
+		log.Printf("[WARN] The locks of the secret version %s were removed", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting instance_id"), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting region"), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting secret_id"), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if _, ok := d.GetOk("version_id"); !ok {
+		if err = d.Set("version_id", versionId); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting version_id"), SecretVersionLocksResourceName, "read")
+			return tfErr.GetDiag()
+		}
+	}
+	if err = d.Set("secret_version_id", versionId); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting secret_version_id"), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_version_alias", alias); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting secret_version_alias"), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("locks", lockMaps); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting locks"), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIbmSmSecretVersionLocksUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionLocksResourceName, "update")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := parseSecretVersionID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionLocksResourceName, "update")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	if d.HasChange("locks") {
+		oldLocks, newLocks := d.GetChange("locks")
+		removed, changed := diffSecretVersionLocks(oldLocks.([]interface{}), newLocks.([]interface{}))
+
+		// The names of the locks are unique per version, so a changed lock is
+		// deleted before it is created again
+		if len(removed) > 0 {
+			if err = deleteSecretVersionLocks(context, secretsManagerClient, secretId, versionId, removed); err != nil {
+				tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionLocksResourceName, "update")
+				return tfErr.GetDiag()
+			}
+		}
+		if len(changed) > 0 {
+			if err = createSecretVersionLocks(context, secretsManagerClient, d, secretId, versionId, changed); err != nil {
+				tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionLocksResourceName, "update")
+				return tfErr.GetDiag()
+			}
+		}
+	}
+
+	return resourceIbmSmSecretVersionLocksRead(context, d, meta)
+}
+
+func resourceIbmSmSecretVersionLocksDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionLocksResourceName, "delete")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := parseSecretVersionID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionLocksResourceName, "delete")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	var names []string
+	for _, lockIntf := range d.Get("locks").([]interface{}) {
+		names = append(names, lockIntf.(map[string]interface{})["name"].(string))
+	}
+	if err = deleteSecretVersionLocks(context, secretsManagerClient, secretId, versionId, names); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), SecretVersionLocksResourceName, "delete")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func createSecretVersionLocks(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, secretId, versionId string, locks []interface{}) error {
+	createSecretVersionLocksBulkOptions := &secretsmanagerv2.CreateSecretVersionLocksBulkOptions{}
+	createSecretVersionLocksBulkOptions.SetSecretID(secretId)
+	createSecretVersionLocksBulkOptions.SetID(versionId)
+	if mode, ok := d.GetOk("mode"); ok {
+		createSecretVersionLocksBulkOptions.SetMode(mode.(string))
+	}
+	lockPrototypes := []secretsmanagerv2.SecretLockPrototype{}
+	for _, lockIntf := range locks {
+		lockMap := lockIntf.(map[string]interface{})
+		lockPrototype := secretsmanagerv2.SecretLockPrototype{
+			Name: core.StringPtr(lockMap["name"].(string)),
+		}
+		if description := lockMap["description"].(string); description != "" {
+			lockPrototype.Description = core.StringPtr(description)
+		}
+		if attributes := lockMap["attributes"].(map[string]interface{}); len(attributes) > 0 {
+			lockPrototype.Attributes = attributes
+		}
+		lockPrototypes = append(lockPrototypes, lockPrototype)
+	}
+	createSecretVersionLocksBulkOptions.SetLocks(lockPrototypes)
+
+	_, response, err := secretsManagerClient.CreateSecretVersionLocksBulkWithContext(context, createSecretVersionLocksBulkOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("CreateSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func deleteSecretVersionLocks(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId, versionId string, names []string) error {
+	deleteSecretVersionLocksBulkOptions := &secretsmanagerv2.DeleteSecretVersionLocksBulkOptions{}
+	deleteSecretVersionLocksBulkOptions.SetSecretID(secretId)
+	deleteSecretVersionLocksBulkOptions.SetID(versionId)
+	deleteSecretVersionLocksBulkOptions.SetName(names)
+
+	_, response, err := secretsManagerClient.DeleteSecretVersionLocksBulkWithContext(context, deleteSecretVersionLocksBulkOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// listSecretVersionLocks returns all the locks of a secret version.
+func listSecretVersionLocks(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId, versionId string) ([]secretsmanagerv2.SecretLock, *core.DetailedResponse, error) {
+	var locks []secretsmanagerv2.SecretLock
+	for {
+		listSecretVersionLocksOptions := &secretsmanagerv2.ListSecretVersionLocksOptions{}
+		listSecretVersionLocksOptions.SetSecretID(secretId)
+		listSecretVersionLocksOptions.SetID(versionId)
+		listSecretVersionLocksOptions.SetOffset(int64(len(locks)))
+		listSecretVersionLocksOptions.SetLimit(secretVersionLocksPageSize)
+
+		collection, response, err := secretsManagerClient.ListSecretVersionLocksWithContext(context, listSecretVersionLocksOptions)
+		if err != nil {
+			return nil, response, err
+		}
+		locks = append(locks, collection.Locks...)
+		if len(collection.Locks) == 0 || collection.TotalCount == nil || int64(len(locks)) >= *collection.TotalCount {
+			return locks, response, nil
+		}
+	}
+}
+
+// diffSecretVersionLocks returns the names of the locks to delete, the locks
+// which were removed or changed, and the locks to create, the locks which
+// were added or changed.
+func diffSecretVersionLocks(oldLocks, newLocks []interface{}) ([]string, []interface{}) {
+	oldByName := map[string]map[string]interface{}{}
+	for _, lockIntf := range oldLocks {
+		lockMap := lockIntf.(map[string]interface{})
+		oldByName[lockMap["name"].(string)] = lockMap
+	}
+	var removed []string
+	var changed []interface{}
+	for _, lockIntf := range newLocks {
+		lockMap := lockIntf.(map[string]interface{})
+		name := lockMap["name"].(string)
+		oldLock, ok := oldByName[name]
+		delete(oldByName, name)
+		if ok && oldLock["description"] == lockMap["description"] && reflect.DeepEqual(oldLock["attributes"], lockMap["attributes"]) {
+			continue
+		}
+		if ok {
+			removed = append(removed, name)
+		}
+		changed = append(changed, lockMap)
+	}
+	for _, lockIntf := range oldLocks {
+		name := lockIntf.(map[string]interface{})["name"].(string)
+		if _, ok := oldByName[name]; ok {
+			removed = append(removed, name)
+		}
+	}
+	return removed, changed
+}
+
+func secretLockToMap(model *secretsmanagerv2.SecretLock) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if model.Name != nil {
+		modelMap["name"] = *model.Name
+	}
+	if model.Description != nil {
+		modelMap["description"] = *model.Description
+	}
+	if model.Attributes != nil {
+		modelMap["attributes"] = model.Attributes
+	}
+	if model.CreatedAt != nil {
+		modelMap["created_at"] = DateTimeToRFC3339(model.CreatedAt)
+	}
+	if model.CreatedBy != nil {
+		modelMap["created_by"] = *model.CreatedBy
+	}
+	return modelMap
+}
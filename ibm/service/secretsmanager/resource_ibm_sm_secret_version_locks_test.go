@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmSecretVersionLocksBasic(t *testing.T) {
+	resourceName := "ibm_sm_secret_version_locks.sm_secret_version_locks"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmSecretVersionLocksDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: secretVersionLocksConfig(`
+					locks {
+						name        = "lock-1"
+						description = "Used by the payments service"
+						attributes = {
+							service = "payments"
+						}
+					}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "secret_version_id"),
+					resource.TestCheckResourceAttr(resourceName, "secret_version_alias", "current"),
+					resource.TestCheckResourceAttr(resourceName, "locks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locks.0.name", "lock-1"),
+					resource.TestCheckResourceAttr(resourceName, "locks.0.attributes.service", "payments"),
+					resource.TestCheckResourceAttrSet(resourceName, "locks.0.created_at"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version_locks.sm_secret_version_locks", "total_count", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: secretVersionLocksConfig(`
+					locks {
+						name        = "lock-1"
+						description = "Used by the billing service"
+					}
+					locks {
+						name = "lock-2"
+					}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "locks.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "locks.0.description", "Used by the billing service"),
+					resource.TestCheckResourceAttr(resourceName, "locks.0.attributes.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "locks.1.name", "lock-2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_id"},
+			},
+		},
+	})
+}
+
+func secretVersionLocksConfig(locks string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id = "%s"
+			region      = "%s"
+			name        = "terraform-test-secret-version-locks"
+			payload     = "secret-credentials"
+		}
+
+		resource "ibm_sm_secret_version_locks" "sm_secret_version_locks" {
+			instance_id = "%s"
+			region      = "%s"
+			secret_id   = ibm_sm_arbitrary_secret.sm_arbitrary_secret.secret_id
+			version_id  = "current"
+			%s
+		}
+
+		data "ibm_sm_secret_version_locks" "sm_secret_version_locks" {
+			instance_id = "%s"
+			region      = "%s"
+			secret_id   = ibm_sm_secret_version_locks.sm_secret_version_locks.secret_id
+			version_id  = ibm_sm_secret_version_locks.sm_secret_version_locks.secret_version_id
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, locks,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
+
+func testAccCheckIbmSmSecretVersionLocksDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+
+	secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_secret_version_locks" {
+			continue
+		}
+
+		listSecretVersionLocksOptions := &secretsmanagerv2.ListSecretVersionLocksOptions{
+			SecretID: core.StringPtr(rs.Primary.Attributes["secret_id"]),
+			ID:       core.StringPtr(rs.Primary.Attributes["secret_version_id"]),
+		}
+
+		// The secret is destroyed with its locks
+		collection, response, err := secretsManagerClient.ListSecretVersionLocks(listSecretVersionLocksOptions)
+		if err == nil && len(collection.Locks) > 0 {
+			return fmt.Errorf("The locks of the secret version %s still exist", rs.Primary.ID)
+		} else if err != nil && response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for the locks of the secret version (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmSecretVersionBasic(t *testing.T) {
+	resourceName := "ibm_sm_secret_version.sm_secret_version"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: secretVersionConfig("secret-credentials-v2", `{"key":"value"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmSecretVersionPayload("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "secret-credentials-v2"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "arbitrary"),
+					resource.TestCheckResourceAttr(resourceName, "alias", "current"),
+					resource.TestCheckResourceAttr(resourceName, "version_custom_metadata.key", "value"),
+				),
+			},
+			resource.TestStep{
+				Config: secretVersionConfig("secret-credentials-v2", `{"key":"modified"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "version_custom_metadata.key", "modified"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"payload"},
+			},
+		},
+	})
+}
+
+func secretVersionConfig(payload, versionCustomMetadata string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id = "%s"
+			region      = "%s"
+			name        = "terraform-test-secret-version"
+			payload     = "secret-credentials"
+			lifecycle {
+				ignore_changes = [payload]
+			}
+		}
+
+		resource "ibm_sm_secret_version" "sm_secret_version" {
+			instance_id             = "%s"
+			region                  = "%s"
+			secret_id               = ibm_sm_arbitrary_secret.sm_arbitrary_secret.secret_id
+			payload                 = "%s"
+			version_custom_metadata = %s
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, payload, versionCustomMetadata)
+}
+
+func testAccCheckIbmSmSecretVersionPayload(n, payload string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		secretIntf, err := getSecret(s, n)
+		if err != nil {
+			return err
+		}
+		secret := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
+		if err := verifyAttr(*secret.Payload, payload, "payload of the current version"); err != nil {
+			return err
+		}
+		return verifyIntAttr(int(*secret.VersionsTotal), 2, "number of versions")
+	}
+}
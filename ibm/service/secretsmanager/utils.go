@@ -53,6 +53,11 @@ const (
 	SecretGroupResourceName  = "ibm_sm_secret_group"
 	SecretGroupsResourceName = "ibm_sm_secret_groups"
 	SecretsResourceName      = "ibm_sm_secrets"
+
+	SecretVersionResourceName      = "ibm_sm_secret_version"
+	SecretVersionsResourceName     = "ibm_sm_secret_versions"
+	SecretVersionLocksResourceName = "ibm_sm_secret_version_locks"
+	SecretRotationResourceName     = "ibm_sm_secret_rotation"
 )
 
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
@@ -224,3 +229,138 @@ func secretVersionMetadataAsPatchFunction(secretVersionMetadataPatch *secretsman
 	}
 	return
 }
+
+// remarshalSecretModel copies the fields of a model returned by the API for the
+// type of a secret to the model with the fields of all the secret types.
+func remarshalSecretModel(model interface{}, result interface{}) error {
+	jsonData, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonData, result)
+}
+
+// secretVersionMetadata returns the fields common to all the secret types of
+// a secret version or of its metadata.
+func secretVersionMetadata(version interface{}) (*secretsmanagerv2.SecretVersionMetadata, error) {
+	metadata := &secretsmanagerv2.SecretVersionMetadata{}
+	if err := remarshalSecretModel(version, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// getSecretVersionMetadata returns the metadata of the version versionId of a
+// secret, a UUID or the `current` or `previous` alias.
+func getSecretVersionMetadata(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId, versionId string) (*secretsmanagerv2.SecretVersionMetadata, *core.DetailedResponse, error) {
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID(versionId)
+
+	versionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	versionMetadata, err := secretVersionMetadata(versionMetadataIntf)
+	return versionMetadata, response, err
+}
+
+// secretVersionMetadataSchema returns the computed attributes of a secret
+// version, shared by the secret version resources and data sources.
+func secretVersionMetadataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"secret_type": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
+		},
+		"secret_name": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The human-readable name of your secret.",
+		},
+		"secret_group_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A UUID identifier, or `default` secret group.",
+		},
+		"alias": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A human-readable alias that describes the secret version. 'Current' is used for version `n` and 'previous' is used for version `n-1`.",
+		},
+		"auto_rotated": &schema.Schema{
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether the version of the secret was created by automatic rotation.",
+		},
+		"created_by": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier that is associated with the entity that created the secret.",
+		},
+		"created_at": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date when a resource was created. The date format follows RFC 3339.",
+		},
+		"downloaded": &schema.Schema{
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether the secret data that is associated with a secret version was retrieved in a call to the service API.",
+		},
+		"payload_available": &schema.Schema{
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether the secret payload is available in this secret version.",
+		},
+		"expiration_date": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date that the secret version expires. The date format follows RFC 3339.",
+		},
+	}
+}
+
+// setSecretVersionMetadata sets the attributes of secretVersionMetadataSchema
+// and the version_custom_metadata of a secret version.
+func setSecretVersionMetadata(d *schema.ResourceData, versionMetadata *secretsmanagerv2.SecretVersionMetadata) error {
+	values := map[string]interface{}{
+		"secret_type":       versionMetadata.SecretType,
+		"secret_name":       versionMetadata.SecretName,
+		"secret_group_id":   versionMetadata.SecretGroupID,
+		"alias":             versionMetadata.Alias,
+		"auto_rotated":      versionMetadata.AutoRotated,
+		"created_by":        versionMetadata.CreatedBy,
+		"created_at":        DateTimeToRFC3339(versionMetadata.CreatedAt),
+		"downloaded":        versionMetadata.Downloaded,
+		"payload_available": versionMetadata.PayloadAvailable,
+		"expiration_date":   DateTimeToRFC3339(versionMetadata.ExpirationDate),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("Error setting %s: %s", k, err)
+		}
+	}
+	if versionMetadata.VersionCustomMetadata != nil {
+		if err := d.Set("version_custom_metadata", versionMetadata.VersionCustomMetadata); err != nil {
+			return fmt.Errorf("Error setting version_custom_metadata: %s", err)
+		}
+	}
+	return nil
+}
+
+// secretVersionID returns the ID of a resource of a secret version.
+func secretVersionID(region, instanceId, secretId, versionId string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", region, instanceId, secretId, versionId)
+}
+
+// parseSecretVersionID returns the region, instance ID, secret ID and version
+// ID of a resource of a secret version.
+func parseSecretVersionID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("Wrong format of resource ID. To import a secret version use the format `<region>/<instance_id>/<secret_id>/<version_id>`")
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
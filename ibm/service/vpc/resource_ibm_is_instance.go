@@ -13,8 +13,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -2669,7 +2671,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return err
 	}
@@ -3218,7 +3220,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return err
 	}
@@ -3240,6 +3242,53 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	if err != nil {
 		return err
 	}
+	instanceproto, err := instancePrototypeByTemplate(d, profile, name, vpcID, zone, image, template)
+	if err != nil {
+		return err
+	}
+
+	options := &vpcv1.CreateInstanceOptions{
+		InstancePrototype: instanceproto,
+	}
+
+	instance, response, err := sess.CreateInstance(options)
+	if err != nil {
+		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
+		return err
+	}
+	d.SetId(*instance.ID)
+
+	log.Printf("[INFO] Instance : %s", *instance.ID)
+	d.Set(isInstanceStatus, instance.Status)
+
+	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
+	if err != nil {
+		return err
+	}
+
+	v := os.Getenv("IC_ENV_TAGS")
+	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+		oldList, newList := d.GetChange(isInstanceTags)
+		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
+		if err != nil {
+			log.Printf(
+				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
+		}
+	}
+	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+		oldList, newList := d.GetChange(isInstanceAccessTags)
+		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
+		if err != nil {
+			log.Printf(
+				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+// instancePrototypeByTemplate returns the prototype of an instance created
+// from template with the arguments of d.
+func instancePrototypeByTemplate(d *schema.ResourceData, profile, name, vpcID, zone, image, template string) (*vpcv1.InstancePrototypeInstanceBySourceTemplate, error) {
 	instanceproto := &vpcv1.InstancePrototypeInstanceBySourceTemplate{
 		SourceTemplate: &vpcv1.InstanceTemplateIdentity{
 			ID: &template,
@@ -3254,7 +3303,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 			for _, clusterNetworkAttachmentsItem := range clusterNetworkAttachmentList {
 				clusterNetworkAttachmentsItemModel, err := ResourceIBMIsInstanceMapToInstanceClusterNetworkAttachmentPrototypeInstanceContext(clusterNetworkAttachmentsItem.(map[string]interface{}))
 				if err != nil {
-					return err
+					return nil, err
 				}
 				clusterNetworkAttachments = append(clusterNetworkAttachments, *clusterNetworkAttachmentsItemModel)
 			}
@@ -3472,7 +3521,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 			enablenat := fmt.Sprintf("network_attachments.%d.enable_infrastructure_nat", i)
 			networkAttachmentsItemModel, err := resourceIBMIsInstanceMapToInstanceNetworkAttachmentPrototype(allowipspoofing, autodelete, enablenat, d, networkAttachmentsItem.(map[string]interface{}))
 			if err != nil {
-				return err
+				return nil, err
 			}
 			networkAttachments = append(networkAttachments, *networkAttachmentsItemModel)
 		}
@@ -3485,7 +3534,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		enablenat := fmt.Sprintf("primary_network_attachment.%d.virtual_network_interface.0.enable_infrastructure_nat", i)
 		primaryNetworkAttachmentModel, err := resourceIBMIsInstanceMapToInstanceNetworkAttachmentPrototype(allowipspoofing, autodelete, enablenat, d, primnetworkattachmentintf.([]interface{})[0].(map[string]interface{}))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachmentModel
 	}
@@ -3553,10 +3602,10 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 			autodelete = reservedipautodeleteok.(bool)
 		}
 		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
+			return nil, fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
 		}
 		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-			return fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
+			return nil, fmt.Errorf("[ERROR] Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 		}
 		if reservedIp != "" {
 			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
@@ -3643,10 +3692,10 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 				autodelete = reservedipautodeleteok.(bool)
 			}
 			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
+				return nil, fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
 			}
 			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
-				return fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
+				return nil, fmt.Errorf("[ERROR] Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 			}
 			if reservedIp != "" {
 				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
@@ -3731,44 +3780,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
 		instanceproto.MetadataService = metadataService
 	}
-
-	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
-	}
-
-	instance, response, err := sess.CreateInstance(options)
-	if err != nil {
-		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
-	}
-	d.SetId(*instance.ID)
-
-	log.Printf("[INFO] Instance : %s", *instance.ID)
-	d.Set(isInstanceStatus, instance.Status)
-
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
-	if err != nil {
-		return err
-	}
-
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
-		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
-		}
-	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
-		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
-		}
-	}
-	return nil
+	return instanceproto, nil
 }
 
 func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
@@ -4286,7 +4298,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return err
 	}
@@ -4780,7 +4792,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return err
 	}
@@ -4852,23 +4864,23 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	return resourceIBMisInstanceUpdate(d, meta)
 }
 
-func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData, interval waiter.Interval) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	communicator := make(chan interface{})
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"retry", isInstanceProvisioning},
-		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
-		Refresh:    isInstanceRefreshFunc(instanceC, id, d, communicator),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.Waiter{
+		Object:   "instance " + id,
+		Pending:  []string{"retry", isInstanceProvisioning},
+		Target:   []string{isInstanceStatusRunning, "available", "failed", ""},
+		Refresh:  waiter.RefreshFunc(isInstanceRefreshFunc(instanceC, id, d, communicator)),
+		Timeout:  timeout,
+		Interval: interval,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator, interval)
 	}
 
 	return stateConf.WaitForState()
@@ -4929,7 +4941,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 	}
 }
 
-func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}, interval waiter.Interval) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
 	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
 	for {
@@ -4948,7 +4960,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
-			_, _ = isWaitForInstanceActionStop(instanceC, waitTimeout, id, d)
+			_, _ = isWaitForInstanceActionStop(instanceC, waitTimeout, id, d, interval)
 			actiontype = "start"
 			createinsactoptions = &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
@@ -5523,7 +5535,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 	// network attachments
 
-	err = handleVolumePrototypesUpdate(d, instanceC)
+	err = handleVolumePrototypesUpdate(d, instanceC, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return err
 	}
@@ -6250,7 +6262,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 		if err != nil {
 			return err
 		}
@@ -6297,7 +6309,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 		if err != nil {
 			return err
 		}
@@ -6334,12 +6346,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 			}
 			if actiontype == "stop" {
-				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return err
 				}
 			} else if actiontype == "start" || actiontype == "reboot" {
-				_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return err
 				}
@@ -6380,7 +6392,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err)
 				}
-				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return err
 				}
@@ -6406,7 +6418,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err)
 						}
-						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 						if err != nil {
 							return err
 						}
@@ -6434,7 +6446,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return err
 				}
@@ -6452,7 +6464,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return err
 				}
@@ -6510,7 +6522,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 		if err != nil {
 			return err
 		}
@@ -6571,7 +6583,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 						if err != nil {
 							return err
 						}
@@ -6590,7 +6602,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						if err != nil {
 							return fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 						if err != nil {
 							return err
 						}
@@ -6702,7 +6714,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					}
 					return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 				}
-				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return err
 				}
@@ -6726,7 +6738,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 			}
-			_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+			_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 			if err != nil {
 				return err
 			}
@@ -6848,7 +6860,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 			}
-			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 			if err != nil {
 				return err
 			}
@@ -6888,7 +6900,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 		if err != nil {
 			return err
 		}
@@ -6965,7 +6977,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 		if err != nil {
 			return err
 		}
@@ -6986,7 +6998,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error while removing volume Attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 				}
-				_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+				_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return err
 				}
@@ -7004,7 +7016,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		return err
 	}
 	if cleanDelete {
-		_, err = isWaitForInstanceDelete(instanceC, d, d.Id())
+		_, err = isWaitForInstanceDelete(instanceC, d, d.Id(), meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 		if err != nil {
 			return err
 		}
@@ -7060,9 +7072,10 @@ func resourceIBMisInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 
 }
 
-func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string, interval waiter.Interval) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
+	w := &waiter.Waiter{
+		Object:  "instance " + id,
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
 		Target:  []string{isInstanceDeleteDone, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -7081,17 +7094,18 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 			}
 			return instance, isInstanceDeleting, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutDelete),
+		Interval: interval,
+		Key:      "vpc/instance/" + id + "/delete",
 	}
 
-	return stateConf.WaitForState()
+	return w.WaitForState()
 }
 
-func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData, interval waiter.Interval) (interface{}, error) {
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Waiter{
+		Object:  "instance " + id,
 		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
 		Target:  []string{isInstanceActionStatusStopped, isInstanceStatusFailed, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -7115,9 +7129,8 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			return instance, *instance.Status, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Interval: interval,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
@@ -7128,9 +7141,10 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 	return stateConf.WaitForState()
 }
 
-func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData, interval waiter.Interval) (interface{}, error) {
 	communicator := make(chan interface{})
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Waiter{
+		Object:  "instance " + id,
 		Pending: []string{isInstanceActionStatusStopped, isInstanceStatusPending, isInstanceActionStatusStopping, isInstanceStatusStarting, isInstanceStatusRestarting},
 		Target:  []string{isInstanceStatusRunning, isInstanceStatusFailed, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -7154,9 +7168,8 @@ func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			return instance, *instance.Status, nil
 		},
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  timeout,
+		Interval: interval,
 	}
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
@@ -7194,16 +7207,16 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 	}
 }
 
-func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string, interval waiter.Interval) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) volume (%s) to be attached.", id, volID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{isInstanceVolumeAttaching},
-		Target:     []string{isInstanceVolumeAttached, ""},
-		Refresh:    isInstanceVolumeRefreshFunc(instanceC, id, volID),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	stateConf := &waiter.Waiter{
+		Object:   "volume attachment " + volID + " of instance " + id,
+		Pending:  []string{isInstanceVolumeAttaching},
+		Target:   []string{isInstanceVolumeAttached, ""},
+		Refresh:  waiter.RefreshFunc(isInstanceVolumeRefreshFunc(instanceC, id, volID)),
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Interval: interval,
 	}
 
 	return stateConf.WaitForState()
@@ -7228,9 +7241,10 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 	}
 }
 
-func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string, interval waiter.Interval) (interface{}, error) {
 
-	stateConf := &resource.StateChangeConf{
+	stateConf := &waiter.Waiter{
+		Object:  "volume attachment " + volID + " of instance " + id,
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
 		Target:  []string{isInstanceDeleteDone, ""},
 		Refresh: func() (interface{}, string, error) {
@@ -7250,9 +7264,8 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 			}
 			return vol, isInstanceVolumeDetaching, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:  d.Timeout(schema.TimeoutUpdate),
+		Interval: interval,
 	}
 
 	return stateConf.WaitForState()
@@ -7918,7 +7931,7 @@ func ResourceValidateInstanceVolumePrototypes(diff *schema.ResourceDiff, meta in
 	return nil
 }
 
-func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1) error {
+func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1, interval waiter.Interval) error {
 	if !d.HasChange("volume_prototypes") || d.IsNewResource() {
 		return nil
 	}
@@ -8066,7 +8079,7 @@ func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1
 				return fmt.Errorf("error attaching volume %s: %w", name, err)
 			}
 
-			_, err = isWaitForInstanceVolumeAttached(instanceC, d, instanceID, *newVolume.ID)
+			_, err = isWaitForInstanceVolumeAttached(instanceC, d, instanceID, *newVolume.ID, interval)
 			if err != nil {
 				return err
 			}
@@ -8092,7 +8105,7 @@ func handleVolumePrototypesUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1
 				return fmt.Errorf("error removing volume %s: %w", name, err)
 			}
 
-			_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceID, volID)
+			_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceID, volID, interval)
 			if err != nil {
 				return err
 			}
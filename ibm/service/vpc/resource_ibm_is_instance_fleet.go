@@ -0,0 +1,680 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/waiter"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceFleetMembers        = "members"
+	isInstanceFleetReplaceMembers = "replace_members"
+)
+
+func ResourceIBMISInstanceFleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMISInstanceFleetCreate,
+		Read:   resourceIBMISInstanceFleetRead,
+		Update: resourceIBMISInstanceFleetUpdate,
+		Delete: resourceIBMISInstanceFleetDelete,
+
+		CustomizeDiff: resourceIBMISInstanceFleetCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMISInstanceFleetImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_fleet", "name"),
+				Description:  "The name prefix of the fleet, members are named <name>-<index>",
+			},
+
+			"instance_template": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "instance template ID used to create the members. Existing members are not replaced when it changes, the fleet is replaced when the template is in another VPC",
+			},
+
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_fleet", "instance_count"),
+				Description:  "The number of instances in the fleet",
+			},
+
+			"subnets": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "list of subnet IDs the members are spread across, in order. The subnet and zone of the instance template are used if not set",
+			},
+
+			isInstanceFleetReplaceMembers: {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Optional:    true,
+				Description: "Indexes of the members to replace. A member is replaced when its index is added to the set",
+			},
+
+			"vpc": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "vpc of the fleet",
+			},
+
+			isInstanceFleetMembers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The members of the fleet, ordered by index",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The index of the member",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The instance ID",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The instance name",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of the instance",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The subnet of the primary network interface or attachment",
+						},
+						"primary_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The primary IP address of the instance",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the instance",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMISInstanceFleetValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             58})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "instance_count",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "0",
+			MaxValue:                   "1000"})
+
+	ibmISInstanceFleetResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_fleet", Schema: validateSchema}
+	return &ibmISInstanceFleetResourceValidator
+}
+
+// resourceIBMISInstanceFleetCustomizeDiff plans a change of the members when
+// the fleet has to be reconciled: the count changed, members are missing,
+// failed or have to be replaced. A template in another VPC replaces the
+// fleet, whose members are looked up in the VPC of its ID.
+func resourceIBMISInstanceFleetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChange("instance_template") && diff.NewValueKnown("instance_template") {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return err
+		}
+		template, err := instanceFleetTemplate(sess, diff.Get("instance_template").(string))
+		if err != nil {
+			return err
+		}
+		if *template.VPC.(*vpcv1.VPCIdentity).ID != diff.Get("vpc").(string) {
+			return diff.ForceNew("instance_template")
+		}
+	}
+	count := diff.Get("instance_count").(int)
+	members := diff.Get(isInstanceFleetMembers).([]interface{})
+	reconcile := diff.HasChange("instance_count") || diff.HasChange(isInstanceFleetReplaceMembers) || len(members) != count
+	for _, m := range members {
+		member := m.(map[string]interface{})
+		if member["status"].(string) == "failed" || member["index"].(int) >= count {
+			reconcile = true
+		}
+	}
+	if reconcile {
+		return diff.SetNewComputed(isInstanceFleetMembers)
+	}
+	return nil
+}
+
+func resourceIBMISInstanceFleetCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+	template, err := instanceFleetTemplate(sess, d.Get("instance_template").(string))
+	if err != nil {
+		return err
+	}
+	vpcID := *template.VPC.(*vpcv1.VPCIdentity).ID
+	d.SetId(fmt.Sprintf("%s/%s", vpcID, name))
+	d.Set("vpc", vpcID)
+
+	owned := map[string]bool{}
+	if err := instanceFleetReconcile(d, sess, template, nil, owned, d.Timeout(schema.TimeoutCreate), instanceFleetPollInterval(meta)); err != nil {
+		// The members created so far are kept in the state, so that they are
+		// not orphaned
+		instanceFleetSetMembers(d, sess, owned)
+		return err
+	}
+	if err := instanceFleetSetMembers(d, sess, owned); err != nil {
+		return err
+	}
+	return resourceIBMISInstanceFleetRead(d, meta)
+}
+
+func resourceIBMISInstanceFleetRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID, name, err := parseInstanceFleetID(d.Id())
+	if err != nil {
+		return err
+	}
+	members, err := listInstanceFleetMembers(sess, vpcID, name, instanceFleetOwnedMembers(d.Get(isInstanceFleetMembers)))
+	if err != nil {
+		return err
+	}
+	d.Set("name", name)
+	d.Set("vpc", vpcID)
+	if err := d.Set(isInstanceFleetMembers, instanceFleetMembersToList(members)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting members for instance fleet %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceIBMISInstanceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	template, err := instanceFleetTemplate(sess, d.Get("instance_template").(string))
+	if err != nil {
+		return err
+	}
+	// The template was not known when the replacement of the fleet was
+	// planned.
+	if vpcID := *template.VPC.(*vpcv1.VPCIdentity).ID; vpcID != d.Get("vpc").(string) {
+		// Keep the previous template so that the replacement is planned
+		o, _ := d.GetChange("instance_template")
+		d.Set("instance_template", o)
+		return fmt.Errorf("[ERROR] Instance template %s is in VPC %s, not in the VPC %s of instance fleet %s, apply again to replace the fleet", d.Get("instance_template"), vpcID, d.Get("vpc"), d.Id())
+	}
+	var replace []int
+	if d.HasChange(isInstanceFleetReplaceMembers) {
+		o, n := d.GetChange(isInstanceFleetReplaceMembers)
+		for _, index := range n.(*schema.Set).Difference(o.(*schema.Set)).List() {
+			replace = append(replace, index.(int))
+		}
+	}
+	o, _ := d.GetChange(isInstanceFleetMembers)
+	owned := instanceFleetOwnedMembers(o)
+	if err := instanceFleetReconcile(d, sess, template, replace, owned, d.Timeout(schema.TimeoutUpdate), instanceFleetPollInterval(meta)); err != nil {
+		// Keep the previous set so that the replacements are planned again
+		o, _ := d.GetChange(isInstanceFleetReplaceMembers)
+		d.Set(isInstanceFleetReplaceMembers, o)
+		instanceFleetSetMembers(d, sess, owned)
+		return err
+	}
+	if err := instanceFleetSetMembers(d, sess, owned); err != nil {
+		return err
+	}
+	return resourceIBMISInstanceFleetRead(d, meta)
+}
+
+func resourceIBMISInstanceFleetDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID, name, err := parseInstanceFleetID(d.Id())
+	if err != nil {
+		return err
+	}
+	members, err := listInstanceFleetMembers(sess, vpcID, name, instanceFleetOwnedMembers(d.Get(isInstanceFleetMembers)))
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for _, instance := range members {
+		if err := deleteInstanceFleetMember(sess, instance); err != nil {
+			return err
+		}
+		ids = append(ids, *instance.ID)
+	}
+	if _, err := isWaitForInstanceFleetMembersDeleted(sess, vpcID, name, ids, d.Timeout(schema.TimeoutDelete), instanceFleetPollInterval(meta)); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMISInstanceFleetImport adopts the instances of the VPC named after
+// the fleet as its members.
+func resourceIBMISInstanceFleetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	vpcID, name, err := parseInstanceFleetID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	members, err := listInstanceFleetMembers(sess, vpcID, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(isInstanceFleetMembers, instanceFleetMembersToList(members)); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error setting members for instance fleet %s: %s", d.Id(), err)
+	}
+	d.Set("instance_count", len(members))
+	return []*schema.ResourceData{d}, nil
+}
+
+// instanceFleetReconcile deletes the members above instance_count, the failed
+// members and the members to replace, then creates the missing members from
+// the template. Status is polled for all the members at once. owned holds the
+// IDs of the members of the fleet, it is updated with the deleted and created
+// members.
+func instanceFleetReconcile(d *schema.ResourceData, sess *vpcv1.VpcV1, template *vpcv1.InstanceTemplate, replace []int, owned map[string]bool, timeout time.Duration, interval waiter.Interval) error {
+	vpcID, name, err := parseInstanceFleetID(d.Id())
+	if err != nil {
+		return err
+	}
+	count := d.Get("instance_count").(int)
+	members, err := listInstanceFleetMembers(sess, vpcID, name, owned)
+	if err != nil {
+		return err
+	}
+
+	remove := map[int]bool{}
+	for _, index := range replace {
+		remove[index] = true
+	}
+	for index, instance := range members {
+		if index >= count || *instance.Status == "failed" {
+			remove[index] = true
+		}
+	}
+	var deleted []string
+	for index := range remove {
+		instance, ok := members[index]
+		if !ok {
+			continue
+		}
+		log.Printf("[INFO] Deleting member %d (%s) of instance fleet %s", index, *instance.ID, d.Id())
+		if err := deleteInstanceFleetMember(sess, instance); err != nil {
+			return err
+		}
+		deleted = append(deleted, *instance.ID)
+		delete(members, index)
+		delete(owned, *instance.ID)
+	}
+	if len(deleted) > 0 {
+		if _, err := isWaitForInstanceFleetMembersDeleted(sess, vpcID, name, deleted, timeout, interval); err != nil {
+			return err
+		}
+	}
+
+	subnets := flex.ExpandStringList(d.Get("subnets").([]interface{}))
+	zones := map[string]string{}
+	for _, subnet := range subnets {
+		if _, ok := zones[subnet]; ok {
+			continue
+		}
+		sub, response, err := sess.GetSubnet(&vpcv1.GetSubnetOptions{ID: &subnet})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting subnet %s: %s\n%s", subnet, err, response)
+		}
+		zones[subnet] = *sub.Zone.Name
+	}
+	var created []string
+	for index := 0; index < count; index++ {
+		if _, ok := members[index]; ok {
+			continue
+		}
+		memberName := instanceFleetMemberName(name, index)
+		subnet, zone := "", ""
+		if len(subnets) > 0 {
+			subnet = subnets[index%len(subnets)]
+			zone = zones[subnet]
+		}
+		// The members are created like an ibm_is_instance from the template
+		// without any other argument.
+		prototype, err := instancePrototypeByTemplate(ResourceIBMISInstance().Data(nil), "", memberName, "", zone, "", *template.ID)
+		if err != nil {
+			return err
+		}
+		if subnet != "" {
+			instanceFleetPlaceInSubnet(prototype, template, subnet)
+		}
+		log.Printf("[INFO] Creating member %d of instance fleet %s", index, d.Id())
+		instance, response, err := sess.CreateInstance(&vpcv1.CreateInstanceOptions{InstancePrototype: prototype})
+		if err != nil {
+			// The members created so far are waited for, so that the next apply
+			// resumes with the remaining ones.
+			if len(created) > 0 {
+				isWaitForInstanceFleetMembersAvailable(sess, vpcID, name, created, timeout, interval)
+			}
+			return fmt.Errorf("[ERROR] Error creating member %s of instance fleet: %s\n%s", memberName, err, response)
+		}
+		created = append(created, *instance.ID)
+		owned[*instance.ID] = true
+	}
+	if len(created) > 0 {
+		if _, err := isWaitForInstanceFleetMembersAvailable(sess, vpcID, name, created, timeout, interval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// instanceFleetPlaceInSubnet overrides the subnet of the primary network
+// interface or attachment of the template, keeping its other settings.
+func instanceFleetPlaceInSubnet(prototype *vpcv1.InstancePrototypeInstanceBySourceTemplate, template *vpcv1.InstanceTemplate, subnet string) {
+	subnetIdentity := &vpcv1.SubnetIdentity{ID: &subnet}
+	if template.PrimaryNetworkAttachment != nil {
+		vni := &vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterface{}
+		if templateVni, ok := template.PrimaryNetworkAttachment.VirtualNetworkInterface.(*vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterface); ok {
+			*vni = *templateVni
+			vni.PrimaryIP = nil
+			vni.Ips = nil
+		}
+		vni.Subnet = subnetIdentity
+		prototype.PrimaryNetworkAttachment = &vpcv1.InstanceNetworkAttachmentPrototype{
+			Name:                    template.PrimaryNetworkAttachment.Name,
+			VirtualNetworkInterface: vni,
+		}
+		return
+	}
+	nic := &vpcv1.NetworkInterfacePrototype{}
+	if template.PrimaryNetworkInterface != nil {
+		*nic = *template.PrimaryNetworkInterface
+		nic.PrimaryIP = nil
+	}
+	nic.Subnet = subnetIdentity
+	prototype.PrimaryNetworkInterface = nic
+}
+
+func instanceFleetTemplate(sess *vpcv1.VpcV1, id string) (*vpcv1.InstanceTemplate, error) {
+	instTempl, response, err := sess.GetInstanceTemplate(&vpcv1.GetInstanceTemplateOptions{ID: &id})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting instance template %s: %s\n%s", id, err, response)
+	}
+	template, ok := instTempl.(*vpcv1.InstanceTemplate)
+	if !ok || template.VPC == nil {
+		return nil, fmt.Errorf("[ERROR] Unable to read the VPC of instance template %s", id)
+	}
+	return template, nil
+}
+
+func instanceFleetPollInterval(meta interface{}) waiter.Interval {
+	return meta.(conns.ClientSession).PollInterval("ibm_is_instance_fleet")
+}
+
+func parseInstanceFleetID(id string) (vpcID, name string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Invalid instance fleet ID %s, expected <vpc>/<name>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func instanceFleetMemberName(name string, index int) string {
+	return fmt.Sprintf("%s-%d", name, index)
+}
+
+// instanceFleetOwnedMembers returns the IDs of the members of a fleet.
+func instanceFleetOwnedMembers(members interface{}) map[string]bool {
+	owned := map[string]bool{}
+	list, _ := members.([]interface{})
+	for _, m := range list {
+		if member, ok := m.(map[string]interface{}); ok && member["id"] != "" {
+			owned[member["id"].(string)] = true
+		}
+	}
+	return owned
+}
+
+// instanceFleetSetMembers sets the members of the fleet with the owned IDs.
+func instanceFleetSetMembers(d *schema.ResourceData, sess *vpcv1.VpcV1, owned map[string]bool) error {
+	vpcID, name, err := parseInstanceFleetID(d.Id())
+	if err != nil {
+		return err
+	}
+	members, err := listInstanceFleetMembers(sess, vpcID, name, owned)
+	if err != nil {
+		return err
+	}
+	if err := d.Set(isInstanceFleetMembers, instanceFleetMembersToList(members)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting members for instance fleet %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// listInstanceFleetMembers returns the instances of the VPC named after the
+// fleet with the owned IDs, by index. All the instances named after the fleet
+// are returned when owned is nil, on import. A single paginated ListInstances
+// call serves any number of members.
+func listInstanceFleetMembers(sess *vpcv1.VpcV1, vpcID, name string, owned map[string]bool) (map[int]vpcv1.Instance, error) {
+	listInstancesOptions := &vpcv1.ListInstancesOptions{
+		VPCID: &vpcID,
+	}
+	members := map[int]vpcv1.Instance{}
+	start := ""
+	for {
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		instances, response, err := sess.ListInstances(listInstancesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
+		}
+		for _, instance := range instances.Instances {
+			suffix := strings.TrimPrefix(*instance.Name, name+"-")
+			index, err := strconv.Atoi(suffix)
+			if suffix == *instance.Name || err != nil || instanceFleetMemberName(name, index) != *instance.Name {
+				continue
+			}
+			if owned != nil && !owned[*instance.ID] {
+				continue
+			}
+			members[index] = instance
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			break
+		}
+	}
+	return members, nil
+}
+
+func instanceFleetMembersToList(members map[int]vpcv1.Instance) []map[string]interface{} {
+	indexes := make([]int, 0, len(members))
+	for index := range members {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	list := make([]map[string]interface{}, 0, len(members))
+	for _, index := range indexes {
+		instance := members[index]
+		member := map[string]interface{}{
+			"index":  index,
+			"id":     *instance.ID,
+			"name":   *instance.Name,
+			"status": *instance.Status,
+		}
+		if instance.Zone != nil {
+			member["zone"] = *instance.Zone.Name
+		}
+		if nac := instance.PrimaryNetworkAttachment; nac != nil {
+			if nac.Subnet != nil {
+				member["subnet"] = *nac.Subnet.ID
+			}
+			if nac.PrimaryIP != nil && nac.PrimaryIP.Address != nil {
+				member["primary_ip"] = *nac.PrimaryIP.Address
+			}
+		} else if nic := instance.PrimaryNetworkInterface; nic != nil {
+			if nic.Subnet != nil {
+				member["subnet"] = *nic.Subnet.ID
+			}
+			if nic.PrimaryIP != nil && nic.PrimaryIP.Address != nil {
+				member["primary_ip"] = *nic.PrimaryIP.Address
+			}
+		}
+		list = append(list, member)
+	}
+	return list
+}
+
+func deleteInstanceFleetMember(sess *vpcv1.VpcV1, instance vpcv1.Instance) error {
+	response, err := sess.DeleteInstance(&vpcv1.DeleteInstanceOptions{ID: instance.ID})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting instance %s: %s\n%s", *instance.Name, err, response)
+	}
+	return nil
+}
+
+// isWaitForInstanceFleetMembersAvailable waits for the given members to be
+// running, with one ListInstances call per poll for all of them. It fails once
+// the other members are running when some members failed.
+func isWaitForInstanceFleetMembersAvailable(sess *vpcv1.VpcV1, vpcID, name string, ids []string, timeout time.Duration, interval waiter.Interval) (interface{}, error) {
+	log.Printf("Waiting for %d members of instance fleet %s/%s to be available.", len(ids), vpcID, name)
+	w := &waiter.Waiter{
+		Object:  fmt.Sprintf("%d members of instance fleet %s/%s", len(ids), vpcID, name),
+		Pending: []string{"retry", isInstanceProvisioning},
+		Target:  []string{isInstanceStatusRunning},
+		Refresh: func() (interface{}, string, error) {
+			members, err := listInstanceFleetMembers(sess, vpcID, name, instanceFleetIDs(ids))
+			if err != nil {
+				return nil, "", err
+			}
+			status := map[string]vpcv1.Instance{}
+			for _, instance := range members {
+				status[*instance.ID] = instance
+			}
+			pending := 0
+			var failed []string
+			for _, id := range ids {
+				instance, ok := status[id]
+				switch {
+				case !ok:
+					// Not listed yet
+					pending++
+				case *instance.Status == "failed":
+					failed = append(failed, *instance.Name)
+				case *instance.Status != isInstanceStatusRunning:
+					pending++
+				}
+			}
+			if pending > 0 {
+				log.Printf("[DEBUG] %d of %d members of instance fleet %s/%s are not running yet", pending, len(ids), vpcID, name)
+				return members, isInstanceProvisioning, nil
+			}
+			if len(failed) > 0 {
+				return members, "failed", fmt.Errorf("[ERROR] Members %s of instance fleet %s/%s failed, they are replaced on the next apply", strings.Join(failed, ", "), vpcID, name)
+			}
+			return members, isInstanceStatusRunning, nil
+		},
+		Timeout:  timeout,
+		Interval: interval,
+	}
+	return w.WaitForState()
+}
+
+// isWaitForInstanceFleetMembersDeleted waits for the given members to be gone,
+// with one ListInstances call per poll for all of them.
+func isWaitForInstanceFleetMembersDeleted(sess *vpcv1.VpcV1, vpcID, name string, ids []string, timeout time.Duration, interval waiter.Interval) (interface{}, error) {
+	log.Printf("Waiting for %d members of instance fleet %s/%s to be deleted.", len(ids), vpcID, name)
+	w := &waiter.Waiter{
+		Object:  fmt.Sprintf("%d members of instance fleet %s/%s", len(ids), vpcID, name),
+		Pending: []string{isInstanceDeleting},
+		Target:  []string{isInstanceDeleteDone},
+		Refresh: func() (interface{}, string, error) {
+			members, err := listInstanceFleetMembers(sess, vpcID, name, instanceFleetIDs(ids))
+			if err != nil {
+				return nil, "", err
+			}
+			remaining := map[string]bool{}
+			for _, id := range ids {
+				remaining[id] = true
+			}
+			for _, instance := range members {
+				if remaining[*instance.ID] {
+					return members, isInstanceDeleting, nil
+				}
+			}
+			return members, isInstanceDeleteDone, nil
+		},
+		Timeout:  timeout,
+		Interval: interval,
+	}
+	return w.WaitForState()
+}
+
+func instanceFleetIDs(ids []string) map[string]bool {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
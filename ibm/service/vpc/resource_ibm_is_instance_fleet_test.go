@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISInstanceFleet_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-fleet-vpc-%d", acc.RandIntRange(t, 10, 100))
+	subnetname := fmt.Sprintf("tf-fleet-subnet-%d", acc.RandIntRange(t, 10, 100))
+	templatename := fmt.Sprintf("tf-fleet-template-%d", acc.RandIntRange(t, 10, 100))
+	name := fmt.Sprintf("tf-fleet-%d", acc.RandIntRange(t, 10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceFleetConfig(vpcname, subnetname, templatename, name, 3, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_fleet.testacc_fleet", "members.#", "3"),
+					resource.TestCheckResourceAttr("ibm_is_instance_fleet.testacc_fleet", "members.2.status", "running"),
+					resource.TestCheckResourceAttrSet("ibm_is_instance_fleet.testacc_fleet", "members.2.primary_ip"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceFleetConfig(vpcname, subnetname, templatename, name, 2, "replace_members = [0]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_fleet.testacc_fleet", "members.#", "2"),
+					resource.TestCheckResourceAttr("ibm_is_instance_fleet.testacc_fleet", "members.0.name", name+"-0"),
+				),
+			},
+			{
+				ResourceName:            "ibm_is_instance_fleet.testacc_fleet",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_template", "subnets", "replace_members"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceFleetConfig(vpcname, subnetname, templatename, name string, count int, extra string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_instance_template" "testacc_template" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		vpc     = ibm_is_vpc.testacc_vpc.id
+		zone    = "%s"
+		keys    = []
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+	}
+
+	resource "ibm_is_instance_fleet" "testacc_fleet" {
+		name              = "%s"
+		instance_template = ibm_is_instance_template.testacc_template.id
+		instance_count    = %d
+		subnets           = [ibm_is_subnet.testacc_subnet.id]
+		%s
+	}`, vpcname, subnetname, acc.ISZoneName, templatename, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, name, count, extra)
+}
+
+func testUnitFleetInstance(index int, id, status, subnet, zone string) string {
+	return fmt.Sprintf(`{"id":%q,"name":"fleet-%d","status":%q,"zone":{"name":%q},"vpc":{"id":"r006-vpc"},"primary_network_interface":{"id":"nic-%s","name":"eth0","primary_ip":{"address":"10.0.0.%d"},"subnet":{"id":%q}}}`, id, index, status, zone, id, index+4, subnet)
+}
+
+func TestUnitIBMISInstanceFleet_scale(t *testing.T) {
+	var (
+		instances = func(instances ...string) []byte {
+			return []byte(`{"instances":[` + strings.Join(instances, ",") + `],"limit":50,"total_count":` + fmt.Sprint(len(instances)) + `}`)
+		}
+		unrelated = `{"id":"r006-other","name":"fleet-web","status":"running","zone":{"name":"us-south-1"},"vpc":{"id":"r006-vpc"}}`
+		// An instance named like a member, which the fleet did not create
+		stray   = testUnitFleetInstance(3, "r006-stray", "running", "r006-sub-b", "us-south-2")
+		created = func(id string) []byte {
+			return []byte(fmt.Sprintf(`{"id":%q,"name":"fleet","status":"pending"}`, id))
+		}
+		m0  = testUnitFleetInstance(0, "r006-i0", "running", "r006-sub-a", "us-south-1")
+		m1  = testUnitFleetInstance(1, "r006-i1", "running", "r006-sub-b", "us-south-2")
+		m2  = testUnitFleetInstance(2, "r006-i2", "failed", "r006-sub-a", "us-south-1")
+		m0b = testUnitFleetInstance(0, "r006-i3", "running", "r006-sub-a", "us-south-1")
+	)
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "GET", Path: "/v1/instance/templates/r006-template", Body: []byte(`{"id":"r006-template","name":"template","vpc":{"id":"r006-vpc"},"zone":{"name":"us-south-1"},"primary_network_interface":{"name":"eth0","subnet":{"id":"r006-sub-template"},"security_groups":[{"id":"r006-sg"}]}}`)},
+		unittest.Fixture{Method: "GET", Path: "/v1/instance/templates/r006-template-2", Body: []byte(`{"id":"r006-template-2","name":"template-2","vpc":{"id":"r006-vpc"},"zone":{"name":"us-south-1"},"primary_network_interface":{"name":"eth0","subnet":{"id":"r006-sub-template"}}}`)},
+		unittest.Fixture{Method: "GET", Path: "/v1/instance/templates/r006-template-other", Body: []byte(`{"id":"r006-template-other","name":"template-other","vpc":{"id":"r006-vpc-other"},"zone":{"name":"us-south-1"},"primary_network_interface":{"name":"eth0","subnet":{"id":"r006-sub-other"}}}`)},
+		unittest.Fixture{Method: "GET", Path: "/v1/subnets/r006-sub-a", Body: []byte(`{"id":"r006-sub-a","zone":{"name":"us-south-1"}}`)},
+		unittest.Fixture{Method: "GET", Path: "/v1/subnets/r006-sub-b", Body: []byte(`{"id":"r006-sub-b","zone":{"name":"us-south-2"}}`)},
+		unittest.Fixture{Method: "GET", Path: "/v1/instances", Query: map[string]string{"vpc.id": "r006-vpc"}, Times: 1, Body: instances(unrelated, stray)},
+		unittest.Fixture{Method: "POST", Path: "/v1/instances", Status: 201, Times: 1, Body: created("r006-i0")},
+		unittest.Fixture{Method: "POST", Path: "/v1/instances", Status: 201, Times: 1, Body: created("r006-i1")},
+		unittest.Fixture{Method: "POST", Path: "/v1/instances", Status: 201, Times: 1, NextState: "created", Body: created("r006-i2")},
+		// The third member fails the apply, it is replaced on the next one.
+		unittest.Fixture{Method: "GET", Path: "/v1/instances", State: "created", Body: instances(m0, unrelated, m1, m2, stray)},
+		// Scaling down to two removes the failed member, and the first member
+		// is replaced.
+		unittest.Fixture{Method: "DELETE", Path: "/v1/instances/r006-i0", Status: 204, Times: 1},
+		unittest.Fixture{Method: "DELETE", Path: "/v1/instances/r006-i2", Status: 204, Times: 1, NextState: "deleted"},
+		unittest.Fixture{Method: "GET", Path: "/v1/instances", State: "deleted", Body: instances(m1, stray)},
+		unittest.Fixture{Method: "POST", Path: "/v1/instances", Status: 201, Times: 1, NextState: "replaced", Body: created("r006-i3")},
+		unittest.Fixture{Method: "GET", Path: "/v1/instances", State: "replaced", Body: instances(m1, m0b, stray)},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "/v1"})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"poll_interval": []interface{}{
+			map[string]interface{}{"resource_types": []interface{}{"ibm_is_instance_fleet"}, "min": "10ms"},
+		},
+	})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	r := p.ResourcesMap["ibm_is_instance_fleet"]
+	template := "r006-template"
+	config := func(count int, replace ...interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":              "fleet",
+			"instance_template": template,
+			"instance_count":    count,
+			"subnets":           []interface{}{"r006-sub-a", "r006-sub-b"},
+			"replace_members":   replace,
+		})
+	}
+	apply := func(state *terraform.InstanceState, c *terraform.ResourceConfig, failure string) *terraform.InstanceState {
+		t.Helper()
+		diff, err := r.Diff(context.Background(), state, c, p.Meta())
+		if err != nil {
+			t.Fatalf("Diff returned an error: %s", err)
+		}
+		if diff == nil {
+			t.Fatal("Diff planned no change")
+		}
+		state, diags := r.Apply(context.Background(), state, diff, p.Meta())
+		if failure == "" && diags.HasError() {
+			t.Fatalf("Apply returned an error: %v", diags)
+		}
+		if failure != "" && (!diags.HasError() || !strings.Contains(diags[0].Summary, failure)) {
+			t.Fatalf("Apply returned %v, expected an error with %q", diags, failure)
+		}
+		return state
+	}
+	seen := 0
+	calls := func() string {
+		requests := m.Requests()
+		var calls []string
+		for _, req := range requests[seen:] {
+			switch {
+			case req.Method == "POST":
+				calls = append(calls, "POST "+strings.Join(strings.Fields(strings.NewReplacer(`"`, "", "{", " ", "}", " ", ",", " ").Replace(req.Body)), " "))
+			case req.Method == "DELETE":
+				calls = append(calls, "DELETE "+req.Path)
+			}
+		}
+		seen = len(requests)
+		sort.Strings(calls)
+		return strings.Join(calls, "\n")
+	}
+
+	// The members are spread across the subnets, keeping the template's
+	// network interface settings.
+	state := apply(nil, config(3), "Members fleet-2 of instance fleet r006-vpc/fleet failed")
+	got := calls()
+	for _, expected := range []string{
+		"name:fleet-0 primary_network_interface: name:eth0 security_groups:[ id:r006-sg ] subnet: id:r006-sub-a source_template: id:r006-template zone: name:us-south-1",
+		"name:fleet-1 primary_network_interface: name:eth0 security_groups:[ id:r006-sg ] subnet: id:r006-sub-b source_template: id:r006-template zone: name:us-south-2",
+		"name:fleet-2 primary_network_interface: name:eth0 security_groups:[ id:r006-sg ] subnet: id:r006-sub-a source_template: id:r006-template zone: name:us-south-1",
+	} {
+		if !strings.Contains(got, expected) {
+			t.Errorf("Create did not send %s:\n%s", expected, got)
+		}
+	}
+	for k, v := range map[string]string{"id": "r006-vpc/fleet", "vpc": "r006-vpc", "members.#": "3", "members.1.id": "r006-i1", "members.1.zone": "us-south-2", "members.1.primary_ip": "10.0.0.5", "members.2.status": "failed"} {
+		if state.Attributes[k] != v {
+			t.Errorf("Create set %s to %q, expected %q", k, state.Attributes[k], v)
+		}
+	}
+
+	// The failed member alone is enough to plan a change.
+	if diff, err := r.Diff(context.Background(), state, config(3), p.Meta()); err != nil || diff == nil || !diff.Attributes["members.#"].NewComputed {
+		t.Errorf("Diff did not plan the replacement of the failed member: %v %v", diff, err)
+	}
+
+	// The stray instance is neither a member nor deleted when scaling down.
+	state = apply(state, config(2, 0), "")
+	if got, expected := calls(), "DELETE /v1/instances/r006-i0\nDELETE /v1/instances/r006-i2\nPOST name:fleet-0 primary_network_interface: name:eth0 security_groups:[ id:r006-sg ] subnet: id:r006-sub-a source_template: id:r006-template zone: name:us-south-1"; got != expected {
+		t.Errorf("Update called\n%s\nexpected\n%s", got, expected)
+	}
+	for k, v := range map[string]string{"members.#": "2", "members.0.id": "r006-i3", "members.1.id": "r006-i1"} {
+		if state.Attributes[k] != v {
+			t.Errorf("Update set %s to %q, expected %q", k, state.Attributes[k], v)
+		}
+	}
+
+	// A template in the same VPC updates the fleet, a template in another
+	// VPC replaces it.
+	for id, requiresNew := range map[string]bool{"r006-template-2": false, "r006-template-other": true} {
+		template = id
+		diff, err := r.Diff(context.Background(), state, config(2), p.Meta())
+		if err != nil || diff == nil || diff.RequiresNew() != requiresNew {
+			t.Errorf("Diff with the template %s planned %v %v, expected a replacement %v", id, diff, err, requiresNew)
+		}
+	}
+	// The VPC of a template which was not known when planning is checked
+	// before creating any member.
+	requests := len(m.Requests())
+	failed, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{
+		"instance_template": {Old: "r006-template", New: "r006-template-other"},
+	}}, p.Meta())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "is in VPC r006-vpc-other") || failed.Attributes["instance_template"] != "r006-template" {
+		t.Errorf("Update with a template in another VPC returned %v %v", failed, diags)
+	}
+	for _, req := range m.Requests()[requests:] {
+		if req.Method != "GET" {
+			t.Errorf("Update with a template in another VPC sent %s %s", req.Method, req.Path)
+		}
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
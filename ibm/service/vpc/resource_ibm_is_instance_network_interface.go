@@ -377,7 +377,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 
 	}
 
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -595,7 +595,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response))
 				}
-				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -612,7 +612,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response))
 				}
-				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return diag.FromErr(err)
 				}
@@ -689,7 +689,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 	}
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -728,7 +728,7 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 		return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 	}
 
-	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -410,7 +410,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 		return fmt.Errorf("[ERROR] Error while attaching volume for instance %s: %q", instanceId, err)
 	}
 	d.SetId(makeTerraformVolAttID(instanceId, *instanceVolAtt.ID))
-	volAtt, err := isWaitForInstanceVolumeAttached(sess, d, instanceId, *instanceVolAtt.ID)
+	volAtt, err := isWaitForInstanceVolumeAttached(sess, d, instanceId, *instanceVolAtt.ID, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 	if err != nil {
 		return err
 	}
@@ -650,7 +650,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", insId, err, response)
 			}
-			_, err = isWaitForInstanceAvailable(instanceC, insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(instanceC, insId, d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 			if err != nil {
 				return err
 			}
@@ -747,7 +747,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d)
+				_, err = isWaitForInstanceAvailable(instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
 			}
 		}
@@ -802,7 +802,7 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while deleting volume attachment (%s) from instance (%s) : %q", id, instanceId, err)
 	}
-	_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceId, id)
+	_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceId, id, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while deleting volume attachment (%s) from instance (%s) on wait : %q", id, instanceId, err)
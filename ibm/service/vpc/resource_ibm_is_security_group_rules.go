@@ -0,0 +1,650 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSecurityGroupRulesSecurityGroup = "security_group"
+	isSecurityGroupRulesAnywhere      = "0.0.0.0/0"
+
+	// securityGroupRulesQuota is the default number of rules a security
+	// group can hold.
+	securityGroupRulesQuota = 250
+)
+
+// ResourceIBMISSecurityGroupRules manages the complete set of rules of a
+// security group. Rules created outside of the resource are removed.
+func ResourceIBMISSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISSecurityGroupRulesCreate,
+		Read:     resourceIBMISSecurityGroupRulesRead,
+		Update:   resourceIBMISSecurityGroupRulesUpdate,
+		Delete:   resourceIBMISSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupRulesSecurityGroup: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The security group identifier",
+			},
+			isSecurityGroupRules: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The complete list of rules of the security group, rules not in the list are removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the security group rule",
+						},
+						isSecurityGroupRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rules", isSecurityGroupRuleDirection),
+						},
+						isSecurityGroupRuleIPVersion: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isSecurityGroupRuleIPVersionDefault,
+							Description:  "IP version: ipv4",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rules", isSecurityGroupRuleIPVersion),
+						},
+						isSecurityGroupRuleProtocol: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      vpcv1.SecurityGroupRuleProtocolAllConst,
+							Description:  "The protocol to enforce: all, icmp, tcp or udp",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rules", isSecurityGroupRuleProtocol),
+						},
+						isSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     isSecurityGroupRulesAnywhere,
+							Description: "The IP address, CIDR block or security group identifier from which (or to which, for outbound rules) the rule allows traffic",
+						},
+						isSecurityGroupRuleLocal: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     isSecurityGroupRulesAnywhere,
+							Description: "The IP address or CIDR block on which (or from which, for outbound rules) the rule allows traffic",
+						},
+						isSecurityGroupRulePortMin: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The inclusive lower bound of the tcp or udp port range, 1 if not set",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rules", isSecurityGroupRulePortMin),
+						},
+						isSecurityGroupRulePortMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The inclusive upper bound of the tcp or udp port range, 65535 if not set",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rules", isSecurityGroupRulePortMax),
+						},
+						isSecurityGroupRuleType: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The icmp traffic type to allow, all types if not set",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rules", isSecurityGroupRuleType),
+						},
+						isSecurityGroupRuleCode: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The icmp traffic code to allow, all codes if not set",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rules", isSecurityGroupRuleCode),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMISSecurityGroupRulesValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	for _, s := range ResourceIBMISSecurityGroupRuleValidator().Schema {
+		validateSchema = append(validateSchema, s)
+	}
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isSecurityGroupRuleProtocol,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "all, icmp, tcp, udp"})
+
+	ibmISSecurityGroupRulesResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_security_group_rules", Schema: validateSchema}
+	return &ibmISSecurityGroupRulesResourceValidator
+}
+
+// securityGroupRule is a security group rule in a form which can be compared
+// between the configuration and the API. Unset ports, icmp type and icmp code
+// are 0.
+type securityGroupRule struct {
+	id        string
+	direction string
+	ipVersion string
+	protocol  string
+	remote    string
+	local     string
+	portMin   int64
+	portMax   int64
+	icmpType  int64
+	icmpCode  int64
+}
+
+// normalize sets the values the API defaults to when they are not provided.
+func (r securityGroupRule) normalize() securityGroupRule {
+	if r.ipVersion == "" {
+		r.ipVersion = isSecurityGroupRuleIPVersionDefault
+	}
+	if r.protocol == "" {
+		r.protocol = vpcv1.SecurityGroupRuleProtocolAllConst
+	}
+	if r.remote == "" {
+		r.remote = isSecurityGroupRulesAnywhere
+	}
+	if r.local == "" {
+		r.local = isSecurityGroupRulesAnywhere
+	}
+	switch r.protocol {
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		if r.portMin == 0 && r.portMax == 0 {
+			r.portMin, r.portMax = 1, 65535
+		} else if r.portMin == 0 {
+			r.portMin = r.portMax
+		} else if r.portMax == 0 {
+			r.portMax = r.portMin
+		}
+		r.icmpType, r.icmpCode = 0, 0
+	case isSecurityGroupRuleProtocolICMP:
+		r.portMin, r.portMax = 0, 0
+	default:
+		r.portMin, r.portMax, r.icmpType, r.icmpCode = 0, 0, 0, 0
+	}
+	return r
+}
+
+// equal reports whether r and o are the same rule, regardless of their id.
+func (r securityGroupRule) equal(o securityGroupRule) bool {
+	r.id, o.id = "", ""
+	return r.normalize() == o.normalize()
+}
+
+func (r securityGroupRule) String() string {
+	s := fmt.Sprintf("%s %s %s remote %s local %s", r.direction, r.ipVersion, r.protocol, r.remote, r.local)
+	if r.portMin != 0 || r.portMax != 0 {
+		s += fmt.Sprintf(" ports %d-%d", r.portMin, r.portMax)
+	}
+	if r.icmpType != 0 || r.icmpCode != 0 {
+		s += fmt.Sprintf(" type %d code %d", r.icmpType, r.icmpCode)
+	}
+	return s
+}
+
+func (r securityGroupRule) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                         r.id,
+		isSecurityGroupRuleDirection: r.direction,
+		isSecurityGroupRuleIPVersion: r.ipVersion,
+		isSecurityGroupRuleProtocol:  r.protocol,
+		isSecurityGroupRuleRemote:    r.remote,
+		isSecurityGroupRuleLocal:     r.local,
+		isSecurityGroupRulePortMin:   int(r.portMin),
+		isSecurityGroupRulePortMax:   int(r.portMax),
+		isSecurityGroupRuleType:      int(r.icmpType),
+		isSecurityGroupRuleCode:      int(r.icmpCode),
+	}
+}
+
+func securityGroupRuleFromMap(m map[string]interface{}) securityGroupRule {
+	return securityGroupRule{
+		id:        m["id"].(string),
+		direction: m[isSecurityGroupRuleDirection].(string),
+		ipVersion: m[isSecurityGroupRuleIPVersion].(string),
+		protocol:  m[isSecurityGroupRuleProtocol].(string),
+		remote:    m[isSecurityGroupRuleRemote].(string),
+		local:     m[isSecurityGroupRuleLocal].(string),
+		portMin:   int64(m[isSecurityGroupRulePortMin].(int)),
+		portMax:   int64(m[isSecurityGroupRulePortMax].(int)),
+		icmpType:  int64(m[isSecurityGroupRuleType].(int)),
+		icmpCode:  int64(m[isSecurityGroupRuleCode].(int)),
+	}
+}
+
+// securityGroupRuleFromAPI converts a rule returned by the API.
+func securityGroupRuleFromAPI(rule vpcv1.SecurityGroupRuleIntf) securityGroupRule {
+	var r securityGroupRule
+	var remote vpcv1.SecurityGroupRuleRemoteIntf
+	var local vpcv1.SecurityGroupRuleLocalIntf
+	switch rule := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		r = securityGroupRule{id: *rule.ID, direction: *rule.Direction, ipVersion: *rule.IPVersion, protocol: *rule.Protocol}
+		remote, local = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		r = securityGroupRule{id: *rule.ID, direction: *rule.Direction, ipVersion: *rule.IPVersion, protocol: *rule.Protocol}
+		if rule.Type != nil {
+			r.icmpType = *rule.Type
+		}
+		if rule.Code != nil {
+			r.icmpCode = *rule.Code
+		}
+		remote, local = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		r = securityGroupRule{id: *rule.ID, direction: *rule.Direction, ipVersion: *rule.IPVersion, protocol: *rule.Protocol}
+		if rule.PortMin != nil {
+			r.portMin = *rule.PortMin
+		}
+		if rule.PortMax != nil {
+			r.portMax = *rule.PortMax
+		}
+		remote, local = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRule:
+		r = securityGroupRule{id: *rule.ID, direction: *rule.Direction, ipVersion: *rule.IPVersion, protocol: *rule.Protocol}
+		if rule.Type != nil {
+			r.icmpType = *rule.Type
+		}
+		if rule.Code != nil {
+			r.icmpCode = *rule.Code
+		}
+		if rule.PortMin != nil {
+			r.portMin = *rule.PortMin
+		}
+		if rule.PortMax != nil {
+			r.portMax = *rule.PortMax
+		}
+		remote, local = rule.Remote, rule.Local
+	}
+	if remote, ok := remote.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			r.remote = *remote.ID
+		} else if remote.Address != nil {
+			r.remote = *remote.Address
+		} else if remote.CIDRBlock != nil {
+			r.remote = *remote.CIDRBlock
+		}
+	}
+	if local, ok := local.(*vpcv1.SecurityGroupRuleLocal); ok && local != nil {
+		if local.Address != nil {
+			r.local = *local.Address
+		} else if local.CIDRBlock != nil {
+			r.local = *local.CIDRBlock
+		}
+	}
+	return r.normalize()
+}
+
+func (r securityGroupRule) prototype() *vpcv1.SecurityGroupRulePrototype {
+	r = r.normalize()
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &r.direction,
+		IPVersion: &r.ipVersion,
+		Protocol:  &r.protocol,
+	}
+	remoteAddress, remoteCIDR, remoteID, _ := inferRemoteSecurityGroup(r.remote)
+	prototype.Remote = &vpcv1.SecurityGroupRuleRemotePrototype{}
+	if remoteAddress != "" {
+		prototype.Remote.(*vpcv1.SecurityGroupRuleRemotePrototype).Address = &remoteAddress
+	} else if remoteCIDR != "" {
+		prototype.Remote.(*vpcv1.SecurityGroupRuleRemotePrototype).CIDRBlock = &remoteCIDR
+	} else {
+		prototype.Remote.(*vpcv1.SecurityGroupRuleRemotePrototype).ID = &remoteID
+	}
+	localAddress, localCIDR, _ := inferLocalSecurityGroup(r.local)
+	prototype.Local = &vpcv1.SecurityGroupRuleLocalPrototype{}
+	if localAddress != "" {
+		prototype.Local.(*vpcv1.SecurityGroupRuleLocalPrototype).Address = &localAddress
+	} else {
+		prototype.Local.(*vpcv1.SecurityGroupRuleLocalPrototype).CIDRBlock = &localCIDR
+	}
+	switch r.protocol {
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		prototype.PortMin = &r.portMin
+		prototype.PortMax = &r.portMax
+	case isSecurityGroupRuleProtocolICMP:
+		if r.icmpType != 0 {
+			prototype.Type = &r.icmpType
+			if r.icmpCode != 0 {
+				prototype.Code = &r.icmpCode
+			}
+		}
+	}
+	return prototype
+}
+
+func (r securityGroupRule) patch() (map[string]interface{}, error) {
+	p := r.prototype()
+	model := &vpcv1.SecurityGroupRulePatch{
+		Direction: p.Direction,
+		IPVersion: p.IPVersion,
+		PortMin:   p.PortMin,
+		PortMax:   p.PortMax,
+		Type:      p.Type,
+		Code:      p.Code,
+	}
+	remote := p.Remote.(*vpcv1.SecurityGroupRuleRemotePrototype)
+	model.Remote = &vpcv1.SecurityGroupRuleRemotePatch{Address: remote.Address, CIDRBlock: remote.CIDRBlock, ID: remote.ID}
+	local := p.Local.(*vpcv1.SecurityGroupRuleLocalPrototype)
+	model.Local = &vpcv1.SecurityGroupRuleLocalPatch{Address: local.Address, CIDRBlock: local.CIDRBlock}
+	patch, err := model.AsPatch()
+	if err != nil {
+		return nil, err
+	}
+	if r.protocol == isSecurityGroupRuleProtocolICMP {
+		// Without a type or a code, the rule allows all icmp traffic.
+		if p.Type == nil {
+			patch["type"] = nil
+		}
+		if p.Code == nil {
+			patch["code"] = nil
+		}
+	}
+	return patch, nil
+}
+
+// securityGroupRulesChanges is the set of API calls turning the live rules of
+// a security group into the desired rules.
+type securityGroupRulesChanges struct {
+	// create are the indexes of the desired rules to create.
+	create []int
+	// update maps the indexes of desired rules to the live rules they
+	// replace in place.
+	update map[int]securityGroupRule
+	// remove are the live rules to delete.
+	remove []securityGroupRule
+}
+
+// diffSecurityGroupRules computes the changes from live to desired. The
+// desired rules which are already live keep their id. Otherwise, a desired
+// rule with the id of a live rule of the same protocol updates it, since the
+// protocol is the only attribute which cannot be updated. The other desired
+// rules are created and the live rules left are removed. The ids of the
+// desired rules are set to those of the live rules they match.
+func diffSecurityGroupRules(desired, live []securityGroupRule) securityGroupRulesChanges {
+	changes := securityGroupRulesChanges{update: map[int]securityGroupRule{}}
+	claimed := make([]bool, len(live))
+	matched := make([]bool, len(desired))
+
+	// Exact matches, preferring the live rule with the same id.
+	for pass := 0; pass < 2; pass++ {
+		for i := range desired {
+			if matched[i] {
+				continue
+			}
+			for j := range live {
+				if claimed[j] || (pass == 0 && desired[i].id != live[j].id) || !desired[i].equal(live[j]) {
+					continue
+				}
+				desired[i].id = live[j].id
+				matched[i], claimed[j] = true, true
+				break
+			}
+		}
+	}
+
+	for i := range desired {
+		if matched[i] {
+			continue
+		}
+		matched[i] = true
+		for j := range live {
+			if !claimed[j] && desired[i].id != "" && desired[i].id == live[j].id && desired[i].normalize().protocol == live[j].protocol {
+				changes.update[i] = live[j]
+				claimed[j] = true
+				break
+			}
+		}
+		if _, ok := changes.update[i]; !ok {
+			desired[i].id = ""
+			changes.create = append(changes.create, i)
+		}
+	}
+
+	for j := range live {
+		if !claimed[j] {
+			changes.remove = append(changes.remove, live[j])
+		}
+	}
+	return changes
+}
+
+func listSecurityGroupRules(sess *vpcv1.VpcV1, securityGroupID string) ([]securityGroupRule, error) {
+	options := &vpcv1.ListSecurityGroupRulesOptions{
+		SecurityGroupID: &securityGroupID,
+	}
+	collection, response, err := sess.ListSecurityGroupRules(options)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the rules of Security Group (%s): %s\n%s", securityGroupID, err, response)
+	}
+	rules := make([]securityGroupRule, 0, len(collection.Rules))
+	for _, rule := range collection.Rules {
+		rules = append(rules, securityGroupRuleFromAPI(rule))
+	}
+	return rules, nil
+}
+
+func expandSecurityGroupRules(d *schema.ResourceData) []securityGroupRule {
+	list := d.Get(isSecurityGroupRules).([]interface{})
+	rules := make([]securityGroupRule, 0, len(list))
+	for _, rule := range list {
+		if rule == nil {
+			continue
+		}
+		rules = append(rules, securityGroupRuleFromMap(rule.(map[string]interface{})))
+	}
+	return rules
+}
+
+func resourceIBMISSecurityGroupRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get(isSecurityGroupRulesSecurityGroup).(string))
+	if err := resourceIBMISSecurityGroupRulesApply(d, meta); err != nil {
+		d.SetId("")
+		return err
+	}
+	return resourceIBMISSecurityGroupRulesRead(d, meta)
+}
+
+func resourceIBMISSecurityGroupRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange(isSecurityGroupRules) {
+		if err := resourceIBMISSecurityGroupRulesApply(d, meta); err != nil {
+			return err
+		}
+	}
+	return resourceIBMISSecurityGroupRulesRead(d, meta)
+}
+
+// resourceIBMISSecurityGroupRulesApply makes the live rules of the security
+// group match the configuration, calling the API only for the rules which
+// differ.
+func resourceIBMISSecurityGroupRulesApply(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	securityGroupID := d.Id()
+	isSecurityGroupRuleKey := "security_group_rule_key_" + securityGroupID
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	live, err := listSecurityGroupRules(sess, securityGroupID)
+	if err != nil {
+		return err
+	}
+	desired := expandSecurityGroupRules(d)
+	changes := diffSecurityGroupRules(desired, live)
+	log.Printf("[INFO] Security Group (%s): %d rules to create, %d to update, %d to remove", securityGroupID, len(changes.create), len(changes.update), len(changes.remove))
+
+	// The ids of the rules are saved as they are created or removed, so
+	// that a failure leaves a state matching the live rules.
+	saveRules := func() {
+		rules := make([]interface{}, 0, len(desired)+len(changes.remove))
+		for _, rule := range desired {
+			if rule.id != "" {
+				rules = append(rules, rule.toMap())
+			}
+		}
+		for _, rule := range changes.remove {
+			if rule.id != "" {
+				rules = append(rules, rule.toMap())
+			}
+		}
+		d.Set(isSecurityGroupRules, rules)
+	}
+	defer saveRules()
+
+	removeRules := func(rules []securityGroupRule) error {
+		for n, rule := range rules {
+			deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+				SecurityGroupID: &securityGroupID,
+				ID:              &rule.id,
+			}
+			response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error Deleting Security Group Rule (%s): %s\n%s", rule.id, err, response)
+			}
+			rules[n].id = ""
+		}
+		return nil
+	}
+
+	// The rules are created before the others are removed, so that the
+	// traffic allowed by a replaced rule keeps flowing until its replacement
+	// exists. Only the removals without which the creations would exceed the
+	// quota of the group are made first.
+	removeFirst := len(live) + len(changes.create) - securityGroupRulesQuota
+	if removeFirst < 0 {
+		removeFirst = 0
+	} else if removeFirst > len(changes.remove) {
+		removeFirst = len(changes.remove)
+	}
+	if err := removeRules(changes.remove[:removeFirst]); err != nil {
+		return err
+	}
+
+	for _, i := range changes.create {
+		options := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &securityGroupID,
+			SecurityGroupRulePrototype: desired[i].prototype(),
+		}
+		rule, response, err := sess.CreateSecurityGroupRule(options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while creating Security Group Rule (%s): %s\n%s", desired[i], err, response)
+		}
+		desired[i].id = securityGroupRuleFromAPI(rule).id
+	}
+
+	for i, rule := range changes.update {
+		patch, err := desired[i].patch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupRulePatch: %s", err)
+		}
+		updateSecurityGroupRuleOptions := &vpcv1.UpdateSecurityGroupRuleOptions{
+			SecurityGroupID:        &securityGroupID,
+			ID:                     &rule.id,
+			SecurityGroupRulePatch: patch,
+		}
+		_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Updating Security Group Rule (%s): %s\n%s", rule.id, err, response)
+		}
+	}
+
+	return removeRules(changes.remove[removeFirst:])
+}
+
+// resourceIBMISSecurityGroupRulesRead sets the live rules, in the order of
+// the state. The rules created outside of the resource are appended, so that
+// they are planned for removal.
+func resourceIBMISSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	securityGroupID := d.Id()
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &securityGroupID,
+	}
+	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Getting Security Group (%s): %s\n%s", securityGroupID, err, response)
+	}
+	live, err := listSecurityGroupRules(sess, securityGroupID)
+	if err != nil {
+		return err
+	}
+
+	liveByID := make(map[string]securityGroupRule, len(live))
+	for _, rule := range live {
+		liveByID[rule.id] = rule
+	}
+	managed := expandSecurityGroupRules(d)
+	rules := make([]interface{}, 0, len(live))
+	for _, rule := range managed {
+		if liveRule, ok := liveByID[rule.id]; ok {
+			// Values left to the API defaults are kept as configured.
+			if !rule.equal(liveRule) {
+				rule = liveRule
+			}
+			rules = append(rules, rule.toMap())
+			delete(liveByID, rule.id)
+		}
+	}
+	for _, rule := range live {
+		if _, ok := liveByID[rule.id]; ok {
+			// All the rules are adopted on import.
+			if len(managed) > 0 {
+				log.Printf("[WARN] Security Group Rule (%s) of Security Group (%s) was created outside of Terraform: %s", rule.id, securityGroupID, rule)
+			}
+			rules = append(rules, rule.toMap())
+		}
+	}
+	d.Set(isSecurityGroupRulesSecurityGroup, securityGroupID)
+	if err := d.Set(isSecurityGroupRules, rules); err != nil {
+		return fmt.Errorf("[ERROR] Error setting rules: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISSecurityGroupRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	securityGroupID := d.Id()
+	isSecurityGroupRuleKey := "security_group_rule_key_" + securityGroupID
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	for _, rule := range expandSecurityGroupRules(d) {
+		if rule.id == "" {
+			continue
+		}
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &securityGroupID,
+			ID:              &rule.id,
+		}
+		response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error Deleting Security Group Rule (%s): %s\n%s", rule.id, err, response)
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSecurityGroupRules_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfsgrules-vpc-%d", acc.RandIntRange(t, 10, 100))
+	name := fmt.Sprintf("tfsgrules-%d", acc.RandIntRange(t, 10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, 22),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.1.port_min", "22"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.1.id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, 443),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.1.port_min", "443"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_security_group_rules.testacc_security_group_rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name string, port int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rules" "testacc_security_group_rules" {
+		security_group = ibm_is_security_group.testacc_security_group.id
+		rules {
+			direction = "outbound"
+		}
+		rules {
+			direction = "inbound"
+			protocol  = "tcp"
+			remote    = "10.0.0.0/8"
+			port_min  = %d
+			port_max  = %d
+		}
+		rules {
+			direction = "inbound"
+			protocol  = "icmp"
+			type      = 8
+		}
+	}`, vpcname, name, port, port)
+}
+
+func testUnitSecurityGroupRule(id, direction, protocol, remote string, ports ...int) string {
+	rule := fmt.Sprintf(`"id":%q,"href":"https://us-south.iaas.cloud.ibm.com/v1/security_groups/r006-sg/rules/%s","direction":%q,"ip_version":"ipv4","protocol":%q,"remote":{"cidr_block":%q},"local":{"cidr_block":"0.0.0.0/0"}`, id, id, direction, protocol, remote)
+	if len(ports) == 2 {
+		rule += fmt.Sprintf(`,"port_min":%d,"port_max":%d`, ports[0], ports[1])
+	} else if protocol == "icmp" {
+		rule += `,"type":8`
+	}
+	return "{" + rule + "}"
+}
+
+func TestUnitIBMISSecurityGroupRules_diff(t *testing.T) {
+	var (
+		outbound  = testUnitSecurityGroupRule("r006-r3", "outbound", "all", "0.0.0.0/0")
+		ssh       = testUnitSecurityGroupRule("r006-r4", "inbound", "tcp", "10.0.0.0/8", 22, 22)
+		https     = testUnitSecurityGroupRule("r006-r4", "inbound", "tcp", "10.0.0.0/8", 443, 443)
+		ping      = testUnitSecurityGroupRule("r006-r5", "inbound", "icmp", "0.0.0.0/0")
+		outOfBand = testUnitSecurityGroupRule("r006-r6", "inbound", "all", "192.168.0.0/16")
+		rules     = func(rules ...string) []byte { return []byte(`{"rules":[` + strings.Join(rules, ",") + `]}`) }
+		rulesPath = "/v1/security_groups/r006-sg/rules"
+		group     = unittest.Fixture{Method: "GET", Path: "/v1/security_groups/r006-sg", Body: []byte(`{"id":"r006-sg","name":"test-sg","rules":[]}`)}
+	)
+	m := unittest.NewMockServer(t,
+		group,
+		// The group initially has an ssh rule from anywhere, a rule created
+		// outside of Terraform and the outbound rule.
+		unittest.Fixture{Method: "GET", Path: rulesPath, NextState: "created", Times: 1, Body: rules(
+			testUnitSecurityGroupRule("r006-r1", "inbound", "tcp", "0.0.0.0/0", 22, 22),
+			testUnitSecurityGroupRule("r006-r2", "inbound", "all", "10.0.0.0/8"),
+			outbound,
+		)},
+		unittest.Fixture{Method: "DELETE", Path: rulesPath + "/r006-r1", Status: 204, Times: 1},
+		unittest.Fixture{Method: "DELETE", Path: rulesPath + "/r006-r2", Status: 204, Times: 1},
+		unittest.Fixture{Method: "POST", Path: rulesPath, Status: 201, Times: 1, Body: []byte(ssh)},
+		unittest.Fixture{Method: "POST", Path: rulesPath, Status: 201, Times: 1, Body: []byte(ping)},
+		unittest.Fixture{Method: "GET", Path: rulesPath, State: "created", NextState: "drifted", Times: 1, Body: rules(outbound, ssh, ping)},
+		// A rule is then added outside of Terraform.
+		unittest.Fixture{Method: "GET", Path: rulesPath, State: "drifted", Body: rules(outbound, ssh, ping, outOfBand)},
+		unittest.Fixture{Method: "DELETE", Path: rulesPath + "/r006-r6", Status: 204, Times: 1},
+		unittest.Fixture{Method: "PATCH", Path: rulesPath + "/r006-r4", NextState: "updated", Times: 1, Body: []byte(https)},
+		unittest.Fixture{Method: "GET", Path: rulesPath, State: "updated", Body: rules(outbound, https, ping)},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "/v1"})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	r := p.ResourcesMap["ibm_is_security_group_rules"]
+	config := func(port int) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"security_group": "r006-sg",
+			"rules": []interface{}{
+				map[string]interface{}{"direction": "outbound"},
+				map[string]interface{}{"direction": "inbound", "protocol": "tcp", "remote": "10.0.0.0/8", "port_min": port, "port_max": port},
+				map[string]interface{}{"direction": "inbound", "protocol": "icmp", "type": 8},
+			},
+		})
+	}
+	apply := func(state *terraform.InstanceState, c *terraform.ResourceConfig) *terraform.InstanceState {
+		t.Helper()
+		diff, err := r.Diff(context.Background(), state, c, p.Meta())
+		if err != nil {
+			t.Fatalf("Diff returned an error: %s", err)
+		}
+		state, diags := r.Apply(context.Background(), state, diff, p.Meta())
+		if diags.HasError() {
+			t.Fatalf("Apply returned an error: %v", diags)
+		}
+		return state
+	}
+	// calls returns the changes made to the rules since the last call, in
+	// the order they were made.
+	seen := 0
+	calls := func() string {
+		requests := m.Requests()
+		var calls []string
+		for _, req := range requests[seen:] {
+			if req.Method != "GET" {
+				calls = append(calls, req.Method+" "+strings.TrimPrefix(req.Path, rulesPath))
+			}
+		}
+		seen = len(requests)
+		return strings.Join(calls, ", ")
+	}
+
+	// Only the rules which differ are created and removed, and the replaced
+	// rules are only removed once the new ones exist.
+	state := apply(nil, config(22))
+	if got, expected := calls(), "POST , POST , DELETE /r006-r1, DELETE /r006-r2"; got != expected {
+		t.Errorf("Create called %s, expected %s", got, expected)
+	}
+	for k, v := range map[string]string{"id": "r006-sg", "rules.#": "3", "rules.0.id": "r006-r3", "rules.1.id": "r006-r4", "rules.2.id": "r006-r5"} {
+		if state.Attributes[k] != v {
+			t.Errorf("Create set %s to %q, expected %q", k, state.Attributes[k], v)
+		}
+	}
+
+	// The rule added outside of Terraform is reported as drift.
+	state, diags := r.RefreshWithoutUpgrade(context.Background(), state, p.Meta())
+	if diags.HasError() {
+		t.Fatalf("Refresh returned an error: %v", diags)
+	}
+	if state.Attributes["rules.#"] != "4" || state.Attributes["rules.3.id"] != "r006-r6" {
+		t.Errorf("Refresh did not report the rule added outside of Terraform: %v", state.Attributes)
+	}
+
+	// A changed rule is updated in place, and the drift is removed.
+	state = apply(state, config(443))
+	if got, expected := calls(), "PATCH /r006-r4, DELETE /r006-r6"; got != expected {
+		t.Errorf("Update called %s, expected %s", got, expected)
+	}
+	if state.Attributes["rules.#"] != "3" || state.Attributes["rules.1.port_min"] != "443" || state.Attributes["rules.1.id"] != "r006-r4" {
+		t.Errorf("Update set %v", state.Attributes)
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnitIBMISSecurityGroupRules_quota(t *testing.T) {
+	// The group has room for one more rule, and two of its rules are
+	// replaced by two new ones.
+	var (
+		liveRules = make([]string, 249)
+		desired   []interface{}
+	)
+	for i := range liveRules {
+		liveRules[i] = testUnitSecurityGroupRule(fmt.Sprintf("r006-q%d", i), "inbound", "tcp", "10.0.0.0/8", i+1, i+1)
+		if i < len(liveRules)-2 {
+			desired = append(desired, map[string]interface{}{"direction": "inbound", "protocol": "tcp", "remote": "10.0.0.0/8", "port_min": i + 1, "port_max": i + 1})
+		}
+	}
+	desired = append(desired,
+		map[string]interface{}{"direction": "inbound", "protocol": "icmp", "type": 8},
+		map[string]interface{}{"direction": "outbound"},
+	)
+	rulesPath := "/v1/security_groups/r006-sg/rules"
+	m := unittest.NewMockServer(t,
+		unittest.Fixture{Method: "GET", Path: "/v1/security_groups/r006-sg", Body: []byte(`{"id":"r006-sg","name":"test-sg","rules":[]}`)},
+		unittest.Fixture{Method: "GET", Path: rulesPath, Body: []byte(`{"rules":[` + strings.Join(liveRules, ",") + `]}`)},
+		unittest.Fixture{Method: "DELETE", Path: rulesPath + "/r006-q247", Status: 204, Times: 1},
+		unittest.Fixture{Method: "DELETE", Path: rulesPath + "/r006-q248", Status: 204, Times: 1},
+		unittest.Fixture{Method: "POST", Path: rulesPath, Status: 201, Times: 1, Body: []byte(testUnitSecurityGroupRule("r006-ping", "inbound", "icmp", "0.0.0.0/0"))},
+		unittest.Fixture{Method: "POST", Path: rulesPath, Status: 201, Times: 1, Body: []byte(testUnitSecurityGroupRule("r006-out", "outbound", "all", "0.0.0.0/0"))},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "/v1"})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	r := p.ResourcesMap["ibm_is_security_group_rules"]
+	c := terraform.NewResourceConfigRaw(map[string]interface{}{"security_group": "r006-sg", "rules": desired})
+	diff, err := r.Diff(context.Background(), nil, c, p.Meta())
+	if err != nil {
+		t.Fatalf("Diff returned an error: %s", err)
+	}
+	if _, diags := r.Apply(context.Background(), nil, diff, p.Meta()); diags.HasError() {
+		t.Fatalf("Apply returned an error: %v", diags)
+	}
+
+	// Only one of the new rules fits, so one removal is made first and the
+	// other one last.
+	var calls []string
+	for _, req := range m.Requests() {
+		if req.Method != "GET" {
+			calls = append(calls, req.Method+" "+strings.TrimPrefix(req.Path, rulesPath))
+		}
+	}
+	if got, expected := strings.Join(calls, ", "), "DELETE /r006-q247, POST , POST , DELETE /r006-q248"; got != expected {
+		t.Errorf("Create called %s, expected %s", got, expected)
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
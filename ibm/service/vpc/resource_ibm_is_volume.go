@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -864,7 +865,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error starting Instance (%s) to which the volume (%s) is attached  : %s\n%s", insId, volId, err, response)
 			}
-			_, err = isWaitForInstanceAvailable(sess, insId, d.Timeout(schema.TimeoutCreate), d)
+			_, err = isWaitForInstanceAvailable(sess, insId, d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 			if err != nil {
 				return err
 			}
@@ -938,7 +939,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", *insId, err, response)
 				}
-				_, err = isWaitForInstanceAvailable(sess, *insId, d.Timeout(schema.TimeoutCreate), d)
+				_, err = isWaitForInstanceAvailable(sess, *insId, d.Timeout(schema.TimeoutCreate), d, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 				if err != nil {
 					return err
 				}
@@ -1041,7 +1042,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error while removing volume attachment %q for instance %s: %q", *volAtt.ID, *volAtt.Instance.ID, err)
 			}
-			_, err = isWaitForInstanceVolumeDetached(sess, d, d.Id(), *volAtt.ID)
+			_, err = isWaitForInstanceVolumeDetached(sess, d, d.Id(), *volAtt.ID, meta.(conns.ClientSession).PollInterval("ibm_is_instance"))
 			if err != nil {
 				return err
 			}
@@ -4,23 +4,25 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccIBMISVPC_basic(t *testing.T) {
 	var vpc string
-	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	name2 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
 	apm := "manual"
 
 	resource.Test(t, resource.TestCase{
@@ -69,10 +71,100 @@ func TestAccIBMISVPC_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitIBMISVPC_basic(t *testing.T) {
+	m := unittest.NewMockServer(t, unittest.LoadFixtures(t, "../../test-fixtures/unittest/is_vpc.json")...)
+	m.UseEndpoints(t, map[string]string{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": "/v1",
+		"IBMCLOUD_GS_API_ENDPOINT":    "",
+		"IBMCLOUD_GT_API_ENDPOINT":    "",
+	})
+
+	unittest.UnitTest(t, m, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitIBMISVPCConfig("tf-unit-vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testunit_vpc", "id", "r006-4727d842-f94f-4a2d-824a-9bc9b02c523b"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testunit_vpc", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testunit_vpc", "default_address_prefixes.us-south-1", "10.240.0.0/18"),
+				),
+			},
+			{
+				Config: testUnitIBMISVPCConfig("tf-unit-vpc-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testunit_vpc", "name", "tf-unit-vpc-renamed"),
+				),
+			},
+		},
+	})
+}
+
+// TestUnitIBMISVPC_inMemory runs the steps of TestUnitIBMISVPC_basic and the
+// destroy through the provider, without the Terraform CLI.
+func TestUnitIBMISVPC_inMemory(t *testing.T) {
+	m := unittest.NewMockServer(t, unittest.LoadFixtures(t, "../../test-fixtures/unittest/is_vpc.json")...)
+	m.UseEndpoints(t, map[string]string{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": "/v1",
+		"IBMCLOUD_GS_API_ENDPOINT":    "",
+		"IBMCLOUD_GT_API_ENDPOINT":    "",
+	})
+	unittest.UseMockCredentials(t)
+
+	p := provider.Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	r := p.ResourcesMap["ibm_is_vpc"]
+	apply := func(state *terraform.InstanceState, name string) *terraform.InstanceState {
+		t.Helper()
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"name": name}), p.Meta())
+		if err != nil {
+			t.Fatalf("Diff with name %s returned an error: %s", name, err)
+		}
+		state, diags := r.Apply(context.Background(), state, diff, p.Meta())
+		if diags.HasError() {
+			t.Fatalf("Apply with name %s returned an error: %v", name, diags)
+		}
+		return state
+	}
+
+	state := apply(nil, "tf-unit-vpc")
+	for k, v := range map[string]string{
+		"id":                                  "r006-4727d842-f94f-4a2d-824a-9bc9b02c523b",
+		"status":                              "available",
+		"default_address_prefixes.us-south-1": "10.240.0.0/18",
+	} {
+		if state.Attributes[k] != v {
+			t.Errorf("Create set %s to %q, expected %q", k, state.Attributes[k], v)
+		}
+	}
+
+	state = apply(state, "tf-unit-vpc-renamed")
+	if name := state.Attributes["name"]; name != "tf-unit-vpc-renamed" {
+		t.Errorf("Update set name to %q, expected tf-unit-vpc-renamed", name)
+	}
+
+	state, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, p.Meta())
+	if diags.HasError() {
+		t.Fatalf("Destroy returned an error: %v", diags)
+	}
+	if state != nil || m.State() != "deleted" {
+		t.Errorf("Destroy left the state %v and the VPC %s", state, m.State())
+	}
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestAccIBMISVPC_dns_manual(t *testing.T) {
 	var vpc string
-	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	name2 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
 	enableHubTrue := true
 	server1Add := "192.168.3.4"
 	server2Add := "192.168.0.4"
@@ -122,8 +214,8 @@ func TestAccIBMISVPC_dns_manual(t *testing.T) {
 }
 func TestAccIBMISVPC_dns_manual2(t *testing.T) {
 	var vpc string
-	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	name2 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
 	enableHubTrue := true
 	server1Add := "192.168.3.4"
 	server2Add := "192.168.0.4"
@@ -174,8 +266,8 @@ func TestAccIBMISVPC_dns_manual2(t *testing.T) {
 }
 func TestAccIBMISVPC_dns_system(t *testing.T) {
 	var vpc string
-	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	name2 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
 	enableHubTrue := true
 	enableHubFalse := false
 	resource.Test(t, resource.TestCase{
@@ -216,16 +308,16 @@ func TestAccIBMISVPC_dns_system(t *testing.T) {
 }
 func TestAccIBMISVPC_dns_delegated(t *testing.T) {
 	var vpc string
-	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	subnet1 := fmt.Sprintf("terraformsubnet-%d", acctest.RandIntRange(10, 100))
-	subnet2 := fmt.Sprintf("terraformsubnet-%d", acctest.RandIntRange(10, 100))
-	subnet3 := fmt.Sprintf("terraformsubnet-%d", acctest.RandIntRange(10, 100))
-	subnet4 := fmt.Sprintf("terraformsubnet-%d", acctest.RandIntRange(10, 100))
-	resourecinstance := fmt.Sprintf("terraformresource-%d", acctest.RandIntRange(10, 100))
-	resolver1 := fmt.Sprintf("terraformresolver-%d", acctest.RandIntRange(10, 100))
-	resolver2 := fmt.Sprintf("terraformresolver-%d", acctest.RandIntRange(10, 100))
-	binding := fmt.Sprintf("terraformbinding-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	name2 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	subnet1 := fmt.Sprintf("terraformsubnet-%d", acc.RandIntRange(t, 10, 100))
+	subnet2 := fmt.Sprintf("terraformsubnet-%d", acc.RandIntRange(t, 10, 100))
+	subnet3 := fmt.Sprintf("terraformsubnet-%d", acc.RandIntRange(t, 10, 100))
+	subnet4 := fmt.Sprintf("terraformsubnet-%d", acc.RandIntRange(t, 10, 100))
+	resourecinstance := fmt.Sprintf("terraformresource-%d", acc.RandIntRange(t, 10, 100))
+	resolver1 := fmt.Sprintf("terraformresolver-%d", acc.RandIntRange(t, 10, 100))
+	resolver2 := fmt.Sprintf("terraformresolver-%d", acc.RandIntRange(t, 10, 100))
+	binding := fmt.Sprintf("terraformbinding-%d", acc.RandIntRange(t, 10, 100))
 	enableHubTrue := true
 	enableHubFalse := false
 	resource.Test(t, resource.TestCase{
@@ -300,13 +392,13 @@ func TestAccIBMISVPC_dns_delegated(t *testing.T) {
 }
 func TestAccIBMISVPC_dns_delegated_first(t *testing.T) {
 	var vpc string
-	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	subnet1 := fmt.Sprintf("terraformsubnet-%d", acctest.RandIntRange(10, 100))
-	subnet2 := fmt.Sprintf("terraformsubnet-%d", acctest.RandIntRange(10, 100))
-	resourecinstance := fmt.Sprintf("terraformresource-%d", acctest.RandIntRange(10, 100))
-	resolver1 := fmt.Sprintf("terraformresolver-%d", acctest.RandIntRange(10, 100))
-	binding := fmt.Sprintf("terraformbinding-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	name2 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	subnet1 := fmt.Sprintf("terraformsubnet-%d", acc.RandIntRange(t, 10, 100))
+	subnet2 := fmt.Sprintf("terraformsubnet-%d", acc.RandIntRange(t, 10, 100))
+	resourecinstance := fmt.Sprintf("terraformresource-%d", acc.RandIntRange(t, 10, 100))
+	resolver1 := fmt.Sprintf("terraformresolver-%d", acc.RandIntRange(t, 10, 100))
+	binding := fmt.Sprintf("terraformbinding-%d", acc.RandIntRange(t, 10, 100))
 	enableHubTrue := true
 	enableHubFalse := false
 	resource.Test(t, resource.TestCase{
@@ -348,7 +440,7 @@ func TestAccIBMISVPC_dns_delegated_first(t *testing.T) {
 
 func TestAccIBMISVPC_basic_apm(t *testing.T) {
 	var vpc string
-	name := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
 	apm1 := "auto"
 	apm2 := "manual"
 
@@ -383,8 +475,8 @@ func TestAccIBMISVPC_basic_apm(t *testing.T) {
 
 func TestAccIBMISVPC_securityGroups(t *testing.T) {
 	var vpc string
-	vpcname := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	sgname := fmt.Sprintf("terraformvpcsg-%d", acctest.RandIntRange(10, 100))
+	vpcname := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	sgname := fmt.Sprintf("terraformvpcsg-%d", acc.RandIntRange(t, 10, 100))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acc.TestAccPreCheck(t) },
 		Providers:    acc.TestAccProviders,
@@ -408,7 +500,7 @@ func TestAccIBMISVPC_securityGroups(t *testing.T) {
 
 func TestAccIBMISVPC_noSGACLRules(t *testing.T) {
 	var vpc string
-	vpcname := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	vpcname := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acc.TestAccPreCheck(t) },
 		Providers:    acc.TestAccProviders,
@@ -482,6 +574,13 @@ func testAccCheckIBMISVPCConfig(name string) string {
 	}`, name)
 
 }
+func testUnitIBMISVPCConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testunit_vpc" {
+		name = "%s"
+	}`, name)
+}
+
 func testAccCheckIBMISVPCDnsSystemConfig(name string, enableHub bool) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -967,8 +1066,8 @@ func testAccCheckIBMISVPCNoSgAclRulesConfig(vpcname string) string {
 
 func TestAccIBMISVPC_basicAddressPrefix(t *testing.T) {
 	var vpc string
-	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
-	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
+	name2 := fmt.Sprintf("terraformvpcuat-%d", acc.RandIntRange(t, 10, 100))
 	apm := "manual"
 
 	resource.Test(t, resource.TestCase{
@@ -1016,8 +1115,8 @@ func TestAccIBMISVPC_basicAddressPrefix(t *testing.T) {
 // TestAccIBMISVPC_ResolverTypeTransition tests the transition of resolver types in a VPC.
 func TestAccIBMISVPC_ResolverTypeTransition(t *testing.T) {
 	var vpc string
-	vpcname1 := fmt.Sprintf("tf-vpc-hub-true-%d", acctest.RandIntRange(10, 100))
-	vpcname2 := fmt.Sprintf("tf-vpc-hub-false-%d", acctest.RandIntRange(10, 100))
+	vpcname1 := fmt.Sprintf("tf-vpc-hub-true-%d", acc.RandIntRange(t, 10, 100))
+	vpcname2 := fmt.Sprintf("tf-vpc-hub-false-%d", acc.RandIntRange(t, 10, 100))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acc.TestAccPreCheck(t) },
@@ -1261,10 +1360,10 @@ func testAccCheckIBMISVPCCustomResolverDelegatedToSystemConfig(vpcname1, vpcname
 // TestAccIBMISVPC_ResolverTypeTransitionDnsNameUpdate tests the transition of resolver types in a VPC.
 func TestAccIBMISVPC_ResolverTypeTransitionDnsNameUpdate(t *testing.T) {
 	var vpc string
-	vpcname1 := fmt.Sprintf("tf-vpc-hub-true-%d", acctest.RandIntRange(10, 100))
-	vpcname2 := fmt.Sprintf("tf-vpc-hub-false-%d", acctest.RandIntRange(10, 100))
-	dnsName := fmt.Sprintf("tf-dns-%d", acctest.RandIntRange(10, 100))
-	dnsNameUpdated := fmt.Sprintf("tf-dns-update-%d", acctest.RandIntRange(10, 100))
+	vpcname1 := fmt.Sprintf("tf-vpc-hub-true-%d", acc.RandIntRange(t, 10, 100))
+	vpcname2 := fmt.Sprintf("tf-vpc-hub-false-%d", acc.RandIntRange(t, 10, 100))
+	dnsName := fmt.Sprintf("tf-dns-%d", acc.RandIntRange(t, 10, 100))
+	dnsNameUpdated := fmt.Sprintf("tf-dns-update-%d", acc.RandIntRange(t, 10, 100))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acc.TestAccPreCheck(t) },
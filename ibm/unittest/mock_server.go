@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package unittest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+//
+// Local IBM Cloud API used to unit test resources and data sources offline.
+//
+
+// Fixture is a recorded request/response pair replayed by a MockServer.
+//
+// A request matches the first fixture, in declaration order, whose method and
+// path are equal to the request's, whose query parameters are all present in
+// the request, whose state is empty or equal to the current server state and
+// which has not been served Times times yet. Serving a fixture with NextState
+// moves the server to that state, which is how a fixture set describes a
+// resource going from pending to available to deleted.
+type Fixture struct {
+	Method    string            `json:"method"`
+	Path      string            `json:"path"`
+	Query     map[string]string `json:"query,omitempty"`
+	State     string            `json:"state,omitempty"`
+	NextState string            `json:"next_state,omitempty"`
+	Times     int               `json:"times,omitempty"`
+
+	Status  int               `json:"status,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// Request is a request received by a MockServer.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   string
+}
+
+// MockServer is an httptest.Server replaying Fixtures.
+type MockServer struct {
+	*httptest.Server
+
+	t        testing.TB
+	mu       sync.Mutex
+	fixtures []Fixture
+	served   []int
+	state    string
+	requests []Request
+}
+
+// NewMockServer starts a MockServer replaying the fixtures. The server is
+// closed when the test and its subtests complete.
+func NewMockServer(t testing.TB, fixtures ...Fixture) *MockServer {
+	t.Helper()
+	m := &MockServer{
+		t:        t,
+		fixtures: fixtures,
+		served:   make([]int, len(fixtures)),
+	}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	t.Cleanup(m.Close)
+	return m
+}
+
+// LoadFixtures reads a JSON array of Fixtures from path.
+func LoadFixtures(t testing.TB, path string) []Fixture {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unable to read fixtures file %s: %s", path, err)
+	}
+	var fixtures []Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		t.Fatalf("Unable to unmarshal fixtures file %s: %s", path, err)
+	}
+	return fixtures
+}
+
+// State returns the current state of the server.
+func (m *MockServer) State() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state
+}
+
+// Requests returns the requests received so far, in order.
+func (m *MockServer) Requests() []Request {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Request(nil), m.requests...)
+}
+
+// Calls returns the number of requests received for method and path.
+func (m *MockServer) Calls(method, path string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := 0
+	for _, r := range m.requests {
+		if strings.EqualFold(r.Method, method) && r.Path == path {
+			calls++
+		}
+	}
+	return calls
+}
+
+// ExpectationsWereMet returns an error listing the fixtures with a Times
+// limit that were not served that many times.
+func (m *MockServer) ExpectationsWereMet() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var missing []string
+	for i, f := range m.fixtures {
+		if f.Times > 0 && m.served[i] < f.Times {
+			missing = append(missing, fmt.Sprintf("%s %s (served %d of %d)", f.Method, f.Path, m.served[i], f.Times))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("[ERROR] Fixtures not served: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (m *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	m.mu.Lock()
+	m.requests = append(m.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Header: r.Header.Clone(),
+		Body:   string(body),
+	})
+	i := m.match(r)
+	if i < 0 {
+		state := m.state
+		m.mu.Unlock()
+		m.t.Errorf("[ERROR] No fixture matches %s %s in state %q", r.Method, r.URL.RequestURI(), state)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotImplemented)
+		fmt.Fprintf(w, `{"errors":[{"code":"no_fixture","message":"No fixture matches %s %s"}]}`, r.Method, r.URL.Path)
+		return
+	}
+	f := m.fixtures[i]
+	m.served[i]++
+	if f.NextState != "" {
+		m.state = f.NextState
+	}
+	m.mu.Unlock()
+
+	if len(f.Body) > 0 {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	for k, v := range f.Headers {
+		w.Header().Set(k, v)
+	}
+	status := f.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	if len(f.Body) > 0 {
+		w.Write(f.Body)
+	}
+}
+
+// match returns the index of the fixture serving r, or -1. It must be called
+// with m.mu held.
+func (m *MockServer) match(r *http.Request) int {
+	query := r.URL.Query()
+	for i, f := range m.fixtures {
+		if !strings.EqualFold(f.Method, r.Method) || f.Path != r.URL.Path {
+			continue
+		}
+		if f.State != "" && f.State != m.state {
+			continue
+		}
+		if f.Times > 0 && m.served[i] >= f.Times {
+			continue
+		}
+		matched := true
+		for k, v := range f.Query {
+			if query.Get(k) != v {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return i
+		}
+	}
+	return -1
+}
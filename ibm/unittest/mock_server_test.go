@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package unittest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+func mockGet(t *testing.T, m *MockServer, path string) (int, string) {
+	resp, err := http.Get(m.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestMockServerStates(t *testing.T) {
+	m := NewMockServer(t,
+		Fixture{Method: "GET", Path: "/things/1", State: "pending", NextState: "available", Body: []byte(`{"status":"pending"}`)},
+		Fixture{Method: "GET", Path: "/things/1", State: "available", Body: []byte(`{"status":"available"}`)},
+		Fixture{Method: "DELETE", Path: "/things/1", NextState: "deleted", Status: 204, Times: 1},
+		Fixture{Method: "GET", Path: "/things/1", State: "deleted", Status: 404},
+		Fixture{Method: "POST", Path: "/things", NextState: "pending", Status: 201, Times: 1, Body: []byte(`{"id":"1"}`)},
+	)
+
+	resp, err := http.Post(m.URL+"/things", "application/json", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 201, resp.StatusCode)
+	assert.Equal(t, "pending", m.State())
+
+	status, body := mockGet(t, m, "/things/1?version=2024-01-01")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"status":"pending"}`, body)
+	for i := 0; i < 2; i++ {
+		_, body = mockGet(t, m, "/things/1")
+		assert.Equal(t, `{"status":"available"}`, body)
+	}
+
+	assert.NotNil(t, m.ExpectationsWereMet())
+	req, _ := http.NewRequest("DELETE", m.URL+"/things/1", nil)
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	assert.Nil(t, m.ExpectationsWereMet())
+
+	status, _ = mockGet(t, m, "/things/1")
+	assert.Equal(t, 404, status)
+	assert.Equal(t, 4, m.Calls("GET", "/things/1"))
+	assert.Len(t, m.Requests(), 6)
+}
+
+func TestMockServerQuery(t *testing.T) {
+	m := NewMockServer(t,
+		Fixture{Method: "GET", Path: "/things", Query: map[string]string{"start": "2"}, Body: []byte(`{"page":2}`)},
+		Fixture{Method: "GET", Path: "/things", Body: []byte(`{"page":1}`)},
+	)
+
+	_, body := mockGet(t, m, "/things?limit=1")
+	assert.Equal(t, `{"page":1}`, body)
+	_, body = mockGet(t, m, "/things?limit=1&start=2")
+	assert.Equal(t, `{"page":2}`, body)
+}
+
+func TestMockServerProviderEndpoints(t *testing.T) {
+	m := NewMockServer(t,
+		Fixture{Method: "GET", Path: "/v1/vpcs/r006-mock", Body: []byte(`{"id":"r006-mock","name":"mock-vpc","status":"available"}`)},
+	)
+	m.UseEndpoints(t, map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "/v1"})
+	UseMockCredentials(t)
+
+	p := provider.Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if diags.HasError() {
+		t.Fatalf("Unable to configure provider: %v", diags)
+	}
+	userDetails, err := p.Meta().(conns.ClientSession).BluemixUserDetails()
+	assert.Nil(t, err)
+	assert.Equal(t, MockAccountID, userDetails.UserAccount)
+
+	vpcClient, err := p.Meta().(conns.ClientSession).VpcV1API()
+	assert.Nil(t, err)
+	vpc, _, err := vpcClient.GetVPC(&vpcv1.GetVPCOptions{ID: core.StringPtr("r006-mock")})
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-vpc", *vpc.Name)
+	assert.Equal(t, 1, m.Calls("GET", "/v1/vpcs/r006-mock"))
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package unittest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+const (
+	// MockRegion is the region the provider is configured with by UnitTest.
+	MockRegion = "us-south"
+	// MockAccountID is the account of the MockIAMToken user.
+	MockAccountID = "mockaccount0000000000000000000000"
+	// MockUserID is the IAM ID of the MockIAMToken user.
+	MockUserID = "IBMid-mockuser00"
+	// MockTrustedProfileID is the trusted profile the provider is configured
+	// with by UnitTest, so that the mock token is never refreshed.
+	MockTrustedProfileID = "iam-Profile-mock0000-0000-0000-0000-000000000000"
+)
+
+// liveEnvs are the environment variables which would make the provider
+// authenticate against IAM or call services on its own.
+var liveEnvs = []string{
+	"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY",
+	"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN",
+	"IAAS_CLASSIC_API_KEY", "SL_API_KEY", "IAAS_CLASSIC_USERNAME", "SL_USERNAME",
+	"IC_VISIBILITY", "IBMCLOUD_VISIBILITY", "IC_ENV_TAGS",
+}
+
+// MockIAMToken returns an unsigned IAM access token for the mock user. The
+// provider only reads the claims of an access token, so it is accepted
+// without any call to IAM.
+func MockIAMToken() string {
+	header, _ := json.Marshal(map[string]interface{}{
+		"alg": "HS256",
+		"typ": "JWT",
+	})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"id":     MockUserID,
+		"iam_id": MockUserID,
+		"sub":    "mockuser@ibm.com",
+		"email":  "mockuser@ibm.com",
+		"iss":    "https://iam.cloud.ibm.com/identity",
+		"exp":    4102444800,
+		"account": map[string]interface{}{
+			"bss": MockAccountID,
+		},
+	})
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(header) + "." + enc.EncodeToString(claims) + "." + enc.EncodeToString([]byte("mock"))
+}
+
+// UseEndpoints routes the given services to the mock server. endpoints maps
+// an endpoint key, such as IBMCLOUD_IS_NG_API_ENDPOINT, to the path prefix
+// of the service on the server, such as /v1. The routes are written to an
+// endpoints file picked up through IBMCLOUD_ENDPOINTS_FILE_PATH and are also
+// set as environment variables, which take precedence over the file.
+func (m *MockServer) UseEndpoints(t *testing.T, endpoints map[string]string) {
+	t.Helper()
+	fileMap := map[string]interface{}{}
+	for key, prefix := range endpoints {
+		url := m.URL + prefix
+		fileMap[key] = map[string]interface{}{
+			"public":  map[string]string{MockRegion: url},
+			"private": map[string]string{MockRegion: url},
+		}
+		t.Setenv(key, url)
+	}
+	data, err := json.Marshal(fileMap)
+	if err != nil {
+		t.Fatalf("Unable to marshal endpoints file: %s", err)
+	}
+	f := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(f, data, 0600); err != nil {
+		t.Fatalf("Unable to write endpoints file: %s", err)
+	}
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", f)
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", f)
+}
+
+// UseMockCredentials configures the provider, through its environment
+// variables, with the MockIAMToken and the MockRegion.
+func UseMockCredentials(t *testing.T) {
+	t.Helper()
+	for _, env := range liveEnvs {
+		t.Setenv(env, "")
+	}
+	t.Setenv("IC_IAM_TOKEN", MockIAMToken())
+	t.Setenv("IC_IAM_PROFILE_ID", MockTrustedProfileID)
+	t.Setenv("IC_REGION", MockRegion)
+}
+
+// ProviderFactories returns provider factories for an ibm provider which is
+// configured from the environment.
+func ProviderFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"ibm": func() (*schema.Provider, error) {
+			return provider.Provider(), nil
+		},
+	}
+}
+
+// UnitTest runs the test case through resource.UnitTest against the mock
+// server, with UseMockCredentials, and fails if a fixture with a Times limit
+// was not served. The endpoints of the services used by the test case must
+// be routed to the server with UseEndpoints beforehand.
+//
+// The test is skipped when no Terraform CLI is available, that is when
+// neither TF_ACC_TERRAFORM_PATH nor TF_ACC_TERRAFORM_VERSION is set and no
+// terraform binary is found in the PATH. Tests which must run without it
+// drive the resource through the Diff and Apply of provider.Provider(), as
+// TestUnitIBMISVPC_inMemory does.
+func UnitTest(t *testing.T, m *MockServer, c resource.TestCase) {
+	t.Helper()
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" && os.Getenv("TF_ACC_TERRAFORM_VERSION") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("Terraform CLI not found, set TF_ACC_TERRAFORM_PATH to run this test")
+		}
+	}
+	UseMockCredentials(t)
+	if c.ProviderFactories == nil && c.Providers == nil {
+		c.ProviderFactories = ProviderFactories()
+	}
+	resource.UnitTest(t, c)
+	if err := m.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package waiter polls the state of an object until it reaches a target
+// state. It replaces resource.StateChangeConf loops with a fixed delay: the
+// polls back off exponentially with jitter while the state does not change,
+// honour context cancellation, log their progress and coalesce the
+// concurrent polls of the same object into one request.
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Interval bounds the delay between two polls. The delay starts at Min,
+// doubles while the state of the object does not change and is capped at Max.
+type Interval struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// DefaultInterval is the interval of the resource types without a default
+// interval of their own.
+var DefaultInterval = Interval{Min: 10 * time.Second, Max: 60 * time.Second}
+
+// defaultIntervals are the default intervals by resource type pattern, the
+// first matching pattern wins.
+var defaultIntervals = []struct {
+	pattern  string
+	interval Interval
+}{
+	{"ibm_is_instance", Interval{Min: 5 * time.Second, Max: 30 * time.Second}},
+	{"ibm_is_instance_fleet", Interval{Min: 10 * time.Second, Max: 30 * time.Second}},
+	{"ibm_is_*", Interval{Min: 5 * time.Second, Max: 60 * time.Second}},
+	{"ibm_container_*", Interval{Min: 30 * time.Second, Max: 2 * time.Minute}},
+	{"ibm_pi_*", Interval{Min: 10 * time.Second, Max: 60 * time.Second}},
+}
+
+// DefaultIntervalFor returns the default interval of resourceType.
+func DefaultIntervalFor(resourceType string) Interval {
+	for _, d := range defaultIntervals {
+		if Matches(d.pattern, resourceType) {
+			return d.interval
+		}
+	}
+	return DefaultInterval
+}
+
+// Matches reports whether resourceType matches pattern, a resource type or a
+// glob pattern like ibm_is_*.
+func Matches(pattern, resourceType string) bool {
+	matched, err := path.Match(pattern, resourceType)
+	return (err == nil && matched) || pattern == resourceType
+}
+
+// RefreshFunc returns the object, its state, or an error ending the wait. It
+// has the signature of resource.StateRefreshFunc so that refresh functions
+// can be reused as they are.
+type RefreshFunc func() (result interface{}, state string, err error)
+
+// notFoundChecks is the number of polls returning no object before the wait
+// fails, as with resource.StateChangeConf.
+const notFoundChecks = 20
+
+// progressInterval is the interval of the progress logs.
+const progressInterval = time.Minute
+
+// Waiter waits for an object to reach one of the Target states.
+type Waiter struct {
+	// Object names the object in the progress logs, e.g. "instance r006-x".
+	Object  string
+	Pending []string
+	Target  []string
+	Refresh RefreshFunc
+	Timeout time.Duration
+	// Interval bounds the delay between the polls, DefaultInterval is used
+	// when it is zero.
+	Interval Interval
+	// Key identifies the polled object, e.g. "vpc/instance/r006-x". The
+	// concurrent polls of waiters with the same key share one Refresh call,
+	// so it must only be shared by waiters with equivalent Refresh functions.
+	Key string
+}
+
+// WaitForState waits without a context, for the resources with legacy CRUD
+// functions.
+func (w *Waiter) WaitForState() (interface{}, error) {
+	return w.Wait(context.Background())
+}
+
+// Wait polls the object until its state is one of Target, and returns the
+// last result. It returns a *resource.TimeoutError when Timeout elapses or
+// the deadline of ctx passes, so that conns.IsResourceTimeoutError keeps
+// working, and the context error when ctx is canceled.
+func (w *Waiter) Wait(ctx context.Context) (interface{}, error) {
+	interval := w.Interval
+	if interval.Min <= 0 {
+		interval = DefaultInterval
+	}
+	if interval.Max < interval.Min {
+		interval.Max = interval.Min
+	}
+	start := time.Now()
+	var deadline <-chan time.Time
+	if w.Timeout > 0 {
+		timer := time.NewTimer(w.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	var result interface{}
+	lastState := ""
+	lastProgress := start
+	notFound := 0
+	delay := interval.Min
+	for {
+		timer := time.NewTimer(jitter(delay))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			// The SDK bounds the context of an operation by its timeout.
+			if ctx.Err() == context.DeadlineExceeded {
+				return result, w.timeoutError(lastState, time.Since(start))
+			}
+			return result, fmt.Errorf("waiting for %s: %w", w.Object, ctx.Err())
+		case <-deadline:
+			timer.Stop()
+			return result, w.timeoutError(lastState, w.Timeout)
+		case <-timer.C:
+		}
+
+		res, state, err := w.refresh()
+		if err != nil {
+			// The poll failed because the deadline passed while it was made.
+			if ctx.Err() == context.DeadlineExceeded {
+				return result, w.timeoutError(lastState, time.Since(start))
+			}
+			return res, err
+		}
+		if res == nil && !contains(w.Target, state) {
+			notFound++
+			if notFound > notFoundChecks {
+				return nil, &resource.NotFoundError{
+					LastError: fmt.Errorf("%s not found", w.Object),
+					Retries:   notFound,
+				}
+			}
+		} else {
+			notFound = 0
+			result = res
+			if contains(w.Target, state) {
+				log.Printf("[DEBUG] %s is %s after %s", w.Object, state, time.Since(start).Round(time.Second))
+				return result, nil
+			}
+			if !contains(w.Pending, state) {
+				return result, &resource.UnexpectedStateError{
+					LastError:     fmt.Errorf("%s is in the unexpected state %q", w.Object, state),
+					State:         state,
+					ExpectedState: w.Target,
+				}
+			}
+		}
+
+		if time.Since(lastProgress) >= progressInterval {
+			log.Printf("[INFO] %s still %s, %s elapsed", w.Object, state, time.Since(start).Round(time.Second))
+			lastProgress = time.Now()
+		}
+		if state != lastState {
+			delay = interval.Min
+		} else if delay < interval.Max {
+			delay *= 2
+			if delay > interval.Max {
+				delay = interval.Max
+			}
+		}
+		lastState = state
+	}
+}
+
+func (w *Waiter) timeoutError(lastState string, timeout time.Duration) *resource.TimeoutError {
+	return &resource.TimeoutError{
+		LastState:     lastState,
+		ExpectedState: w.Target,
+		Timeout:       timeout.Round(time.Millisecond),
+	}
+}
+
+// jitter returns a random delay between three quarters and all of delay, so
+// that the waiters started together do not poll together.
+func jitter(delay time.Duration) time.Duration {
+	return delay - time.Duration(rand.Int63n(int64(delay/4)+1))
+}
+
+func contains(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// poll is a Refresh call shared by the concurrent polls of the same key.
+type poll struct {
+	done   chan struct{}
+	result interface{}
+	state  string
+	err    error
+}
+
+var (
+	pollsMu sync.Mutex
+	polls   = map[string]*poll{}
+)
+
+// refresh calls Refresh, or waits for the call in flight for the same Key.
+func (w *Waiter) refresh() (interface{}, string, error) {
+	if w.Key == "" {
+		return w.Refresh()
+	}
+	pollsMu.Lock()
+	if p, ok := polls[w.Key]; ok {
+		pollsMu.Unlock()
+		<-p.done
+		return p.result, p.state, p.err
+	}
+	p := &poll{done: make(chan struct{})}
+	polls[w.Key] = p
+	pollsMu.Unlock()
+
+	p.result, p.state, p.err = w.Refresh()
+	pollsMu.Lock()
+	delete(polls, w.Key)
+	pollsMu.Unlock()
+	close(p.done)
+	return p.result, p.state, p.err
+}
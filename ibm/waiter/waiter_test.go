@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package waiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestWaiterBackoff(t *testing.T) {
+	states := []string{"pending", "pending", "pending", "starting", "starting", "running"}
+	var polls []time.Time
+	w := &Waiter{
+		Object:   "instance test",
+		Pending:  []string{"pending", "starting"},
+		Target:   []string{"running"},
+		Interval: Interval{Min: 20 * time.Millisecond, Max: 60 * time.Millisecond},
+		Refresh: func() (interface{}, string, error) {
+			polls = append(polls, time.Now())
+			state := states[len(polls)-1]
+			return state, state, nil
+		},
+	}
+	result, err := w.WaitForState()
+	if err != nil || result != "running" {
+		t.Fatalf("Wait returned %v, %v", result, err)
+	}
+	// The delay doubles while the state is the same, up to Max, and is reset
+	// when the state changes.
+	expected := []time.Duration{20, 40, 60, 20, 40}
+	for i, delay := range expected {
+		delay *= time.Millisecond
+		if got := polls[i+1].Sub(polls[i]); got < delay*3/4 || got > delay+30*time.Millisecond {
+			t.Errorf("Poll %d was %s after the previous one, expected about %s", i+1, got, delay)
+		}
+	}
+}
+
+func isTimeout(err error) bool {
+	_, ok := err.(*resource.TimeoutError)
+	return ok
+}
+
+func TestWaiterErrors(t *testing.T) {
+	interval := Interval{Min: time.Millisecond, Max: 5 * time.Millisecond}
+	pending := func() (interface{}, string, error) { return "x", "pending", nil }
+
+	w := &Waiter{Object: "test", Pending: []string{"pending"}, Target: []string{"done"}, Refresh: pending, Interval: interval, Timeout: 30 * time.Millisecond}
+	_, err := w.WaitForState()
+	if !isTimeout(err) || err.(*resource.TimeoutError).LastState != "pending" {
+		t.Errorf("Wait returned %v after the timeout, expected a *resource.TimeoutError", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	w = &Waiter{Object: "test", Pending: []string{"pending"}, Target: []string{"done"}, Refresh: pending, Interval: interval}
+	if _, err := w.Wait(ctx); !isTimeout(err) {
+		t.Errorf("Wait returned %v after the deadline of the context, expected a *resource.TimeoutError", err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(30*time.Millisecond, cancel)
+	if _, err := w.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait returned %v after the context was canceled", err)
+	}
+
+	w = &Waiter{Object: "test", Pending: []string{"pending"}, Target: []string{"done"}, Interval: interval, Refresh: func() (interface{}, string, error) {
+		return "x", "failed", nil
+	}}
+	if _, err := w.WaitForState(); err == nil {
+		t.Error("Wait accepted an unexpected state")
+	}
+
+	refreshErr := errors.New("refresh failed")
+	w = &Waiter{Object: "test", Pending: []string{"pending"}, Target: []string{"done"}, Interval: interval, Refresh: func() (interface{}, string, error) {
+		return nil, "", refreshErr
+	}}
+	if _, err := w.WaitForState(); err != refreshErr {
+		t.Errorf("Wait returned %v, expected the refresh error", err)
+	}
+}
+
+func TestWaiterCoalesce(t *testing.T) {
+	var calls int32
+	refresh := func() (interface{}, string, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		return "x", "done", nil
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := &Waiter{Object: "test", Target: []string{"done"}, Refresh: refresh, Key: "test/object", Interval: Interval{Min: 10 * time.Millisecond}}
+			if _, err := w.WaitForState(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls >= 5 {
+		t.Errorf("The concurrent polls of the same key made %d calls", calls)
+	}
+}
+
+func TestDefaultIntervalFor(t *testing.T) {
+	for resourceType, expected := range map[string]Interval{
+		"ibm_is_instance":       {5 * time.Second, 30 * time.Second},
+		"ibm_is_vpc":            {5 * time.Second, 60 * time.Second},
+		"ibm_container_cluster": {30 * time.Second, 2 * time.Minute},
+		"ibm_database":          DefaultInterval,
+	} {
+		if got := DefaultIntervalFor(resourceType); got != expected {
+			t.Errorf("DefaultIntervalFor(%s) returned %v, expected %v", resourceType, got, expected)
+		}
+	}
+}
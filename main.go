@@ -3,6 +3,7 @@ package main
 import (
 	"log"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -13,4 +14,8 @@ func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: provider.Provider,
 	})
+	// Summarize the trace of the API calls, if any, once Terraform is done
+	// with the provider.
+	conns.CloseTrace()
+	conns.CloseDriftReport()
 }